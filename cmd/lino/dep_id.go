@@ -18,6 +18,7 @@
 package main
 
 import (
+	"io"
 	"os"
 
 	infra "github.com/cgi-fr/lino/internal/infra/id"
@@ -37,8 +38,13 @@ func idStorageFactory() func(string, string) domain.Storage {
 	}
 }
 
-func idExporter() domain.Exporter {
-	return infra.NewGraphVizExporter()
+func idExporterFactory() map[string]func(io.Writer) domain.Exporter {
+	return map[string]func(io.Writer) domain.Exporter{
+		"graphviz": func(io.Writer) domain.Exporter { return infra.NewGraphVizExporter() },
+		"mermaid":  func(w io.Writer) domain.Exporter { return infra.NewMermaidExporter(w) },
+		"plantuml": func(w io.Writer) domain.Exporter { return infra.NewPlantUMLExporter(w) },
+		"svg":      func(w io.Writer) domain.Exporter { return infra.NewSVGExporter(w) },
+	}
 }
 
 func idJSONStorage(file os.File) domain.Storage {
@@ -56,6 +56,16 @@ func pullKeyStoreFactory() func(file io.ReadCloser, keys []string) (domain.KeySt
 	}
 }
 
+func pullFileStoreFactory() func(dir string) domain.FileStore {
+	return func(dir string) domain.FileStore {
+		return infra.NewFileStore(dir)
+	}
+}
+
+func pullObserver() domain.Observer {
+	return infra.NewObserver()
+}
+
 func traceListner(file *os.File) domain.TraceListener {
 	return infra.NewJSONTraceListener(file)
 }
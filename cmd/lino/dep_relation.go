@@ -18,6 +18,7 @@
 package main
 
 import (
+	"github.com/cgi-fr/lino/internal/infra/commonsql"
 	infra "github.com/cgi-fr/lino/internal/infra/relation"
 	domain "github.com/cgi-fr/lino/pkg/relation"
 )
@@ -38,3 +39,14 @@ func relationExtractorFactory() map[string]domain.ExtractorFactory {
 		"ws":         &infra.WSExtractorFactory{},
 	}
 }
+
+func relationInclusionCheckerFactory() map[string]domain.InclusionCheckerFactory {
+	return map[string]domain.InclusionCheckerFactory{
+		"postgres":   infra.NewSQLInclusionCheckerFactory(commonsql.PostgresDialect{}),
+		"godror":     infra.NewSQLInclusionCheckerFactory(commonsql.OracleDialect{}),
+		"godror-raw": infra.NewSQLInclusionCheckerFactory(commonsql.OracleDialect{}),
+		"mysql":      infra.NewSQLInclusionCheckerFactory(commonsql.MariadbDialect{}),
+		"db2":        infra.NewSQLInclusionCheckerFactory(commonsql.Db2Dialect{}),
+		"sqlserver":  infra.NewSQLInclusionCheckerFactory(commonsql.SQLServerDialect{}),
+	}
+}
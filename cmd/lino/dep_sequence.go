@@ -31,7 +31,9 @@ func sequenceUpdatorFactory() map[string]domain.UpdatorFactory {
 		"postgres":   infra.NewPostgresUpdatorFactory(),
 		"godror":     infra.NewOracleUpdatorFactory(),
 		"godror-raw": infra.NewOracleUpdatorFactory(),
-		// "db2":        infra.NewDb2UpdatorFactory(),
+		"mysql":      infra.NewMariadbUpdatorFactory(),
+		"db2":        infra.NewDb2UpdatorFactory(),
+		"sqlserver":  infra.NewSQLServerUpdatorFactory(),
 		// "http":       infra.NewHTTPUpdatorFactory(),
 	}
 }
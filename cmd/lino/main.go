@@ -208,11 +208,11 @@ func initConfig() {
 
 	analyse.Inject(tableStorage(), dataconnectorStorage(), analyseDataSourceFactory())
 	dataconnector.Inject(dataconnectorStorage(), dataPingerFactory())
-	relation.Inject(dataconnectorStorage(), relationStorage(), relationExtractorFactory())
+	relation.Inject(dataconnectorStorage(), relationStorage(), relationExtractorFactory(), tableExtractorFactory(), relationInclusionCheckerFactory())
 	table.Inject(dataconnectorStorage(), tableStorage(), tableExtractorFactory())
 	sequence.Inject(dataconnectorStorage(), tableStorage(), sequenceStorage(), sequenceUpdatorFactory())
-	id.Inject(idStorageFile, relationStorage(), idExporter(), idJSONStorage(*os.Stdout))
-	pull.Inject(dataconnectorStorage(), relationStorage(), tableStorage(), idStorageFactory(), pullDataSourceFactory(), pullRowExporterFactory(), pullRowReaderFactory(), pullKeyStoreFactory(), traceListner(os.Stderr))
+	id.Inject(idStorageFile, relationStorage(), idExporterFactory(), idJSONStorage(*os.Stdout))
+	pull.Inject(dataconnectorStorage(), relationStorage(), tableStorage(), idStorageFactory(), pullDataSourceFactory(), pullRowExporterFactory(), pullRowReaderFactory(), pullKeyStoreFactory(), traceListner(os.Stderr), pullFileStoreFactory(), pullObserver())
 	push.Inject(dataconnectorStorage(), relationStorage(), tableStorage(), idStorageFactory(), pushDataDestinationFactory(), pushRowIteratorFactory(), pushRowExporterFactory(), pushTranslator(), pushObserver())
 	query.Inject(dataconnectorStorage(), queryDataSourceFactory())
 }
@@ -33,6 +33,7 @@
 func newAddCommand(fullName string, err *os.File, out *os.File, in *os.File) *cobra.Command {
 	var flagReadonly bool
 	var flagSchema string
+	var flagSchemas []string
 	var flagAskPassword bool
 	var flagPasswordFromEnv string
 	var flagUserFromEnv string
@@ -92,6 +93,7 @@ func newAddCommand(fullName string, err *os.File, out *os.File, in *os.File) *co
 				URL:      u.URL.String(),
 				ReadOnly: flagReadonly,
 				Schema:   flagSchema,
+				Schemas:  flagSchemas,
 				User: dataconnector.ValueHolder{
 					Value:        "",
 					ValueFromEnv: flagUserFromEnv,
@@ -114,6 +116,7 @@ func newAddCommand(fullName string, err *os.File, out *os.File, in *os.File) *co
 	}
 	cmd.Flags().BoolVarP(&flagReadonly, "read-only", "r", false, "Write protection flag that prevents modification")
 	cmd.Flags().StringVarP(&flagSchema, "schema", "s", "", "Default schema to use with that dataconnector")
+	cmd.Flags().StringSliceVar(&flagSchemas, "schemas", []string{}, "Other schemas holding tables related to the tables of the default schema (relations are extracted across all schemas)")
 	cmd.Flags().StringVarP(&flagPasswordFromEnv, "password-from-env", "P", "", "Name of environment variable containing password")
 	cmd.Flags().StringVarP(&flagUserFromEnv, "user-from-env", "U", "", "Name of environment variable containing username")
 	cmd.Flags().StringVarP(&flagUserValue, "user", "u", "", "Username to connect")
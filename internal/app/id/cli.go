@@ -19,6 +19,7 @@
 
 import (
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/spf13/cobra"
@@ -30,23 +31,23 @@
 var (
 	idStorageFactory  func(string) id.Storage
 	relStorage        relation.Storage
-	idExporter        id.Exporter
+	idExporters       map[string]func(io.Writer) id.Exporter
 	idJSONExporter    id.Storage
 	ingressDescriptor string
 )
 
 // Inject dependencies
-func Inject(ids func(string) id.Storage, rels relation.Storage, ex id.Exporter, jSONEx id.Storage) {
+func Inject(ids func(string) id.Storage, rels relation.Storage, exmap map[string]func(io.Writer) id.Exporter, jSONEx id.Storage) {
 	idStorageFactory = ids
 	relStorage = rels
-	idExporter = ex
+	idExporters = exmap
 	idJSONExporter = jSONEx
 }
 
 // NewCommand implements the cli id command
 func NewCommand(fullName string, err *os.File, out *os.File, in *os.File) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "id {create,display-plan,show-graph,export,set-start-table,set-child-lookup,set-parent-lookup} [arguments ...]",
+		Use:     "id {create,display-plan,show-graph,export,diff,merge,set-start-table,set-child-lookup,set-parent-lookup} [arguments ...]",
 		Short:   "Manage ingress descriptor",
 		Long:    "",
 		Example: fmt.Sprintf("  %[1]s id create mydatabase public.customer", fullName),
@@ -55,6 +56,8 @@ func NewCommand(fullName string, err *os.File, out *os.File, in *os.File) *cobra
 	cmd.AddCommand(newDisplayPlanCommand(fullName, err, out, in))
 	cmd.AddCommand(newShowGraphCommand(fullName, err, out, in))
 	cmd.AddCommand(newExportCommand(fullName, err, out, in))
+	cmd.AddCommand(newDiffCommand(fullName, err, out, in))
+	cmd.AddCommand(newMergeCommand(fullName, err, out, in))
 	cmd.AddCommand(newSetStartTableCommand(fullName, err, out, in))
 	cmd.AddCommand(newSetChildLookupCommand(fullName, err, out, in))
 	cmd.AddCommand(newSetParentLookupCommand(fullName, err, out, in))
@@ -62,6 +65,9 @@ func NewCommand(fullName string, err *os.File, out *os.File, in *os.File) *cobra
 	cmd.AddCommand(newSetChildSelectCommand(fullName, err, out, in))
 	cmd.AddCommand(newSetParentWhereCommand(fullName, err, out, in))
 	cmd.AddCommand(newSetParentSelectCommand(fullName, err, out, in))
+	cmd.AddCommand(newSetChildOrderCommand(fullName, err, out, in))
+	cmd.AddCommand(newSetChildLimitCommand(fullName, err, out, in))
+	cmd.AddCommand(newSetParentOrderCommand(fullName, err, out, in))
 	cmd.PersistentFlags().StringVarP(&ingressDescriptor, "ingress-descriptor", "i", "ingress-descriptor.yaml", "Ingress descriptor filename")
 	cmd.SetOut(out)
 	cmd.SetErr(err)
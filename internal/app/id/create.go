@@ -21,6 +21,7 @@
 	"fmt"
 	"os"
 
+	"github.com/cgi-fr/lino/internal/app/namefilter"
 	infra "github.com/cgi-fr/lino/internal/infra/id"
 	"github.com/cgi-fr/lino/pkg/id"
 	"github.com/spf13/cobra"
@@ -28,11 +29,17 @@
 
 // newCreateCommand implements the cli id create command
 func newCreateCommand(fullName string, err *os.File, out *os.File, in *os.File) *cobra.Command {
+	var maxDepth uint
+	var childrenOnly bool
+	var parentsOnly bool
+	var exclude []string
+	var lookUpParents bool
+
 	cmd := &cobra.Command{
 		Use:     "create [Start table]",
 		Short:   "Create ingress descriptor",
 		Long:    "",
-		Example: fmt.Sprintf("  %[1]s id create public.customer", fullName),
+		Example: fmt.Sprintf("  %[1]s id create public.customer\n  %[1]s id create public.customer --max-depth 2 --children-only --exclude 'audit_*'", fullName),
 		Args:    cobra.ExactArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
 			table := args[0]
@@ -45,7 +52,31 @@ func newCreateCommand(fullName string, err *os.File, out *os.File, in *os.File)
 
 			reader := infra.NewRelationReader(relations)
 
-			e := id.Create(table, []string{}, reader, idStorageFactory(ingressDescriptor))
+			if childrenOnly && parentsOnly {
+				fmt.Fprintln(err, "--children-only and --parents-only can't be used together") //nolint:errcheck
+				os.Exit(1)
+			}
+
+			filter, e2 := namefilter.New(nil, exclude)
+			if e2 != nil {
+				fmt.Fprintln(err, e2.Error()) //nolint:errcheck
+				os.Exit(1)
+			}
+
+			options := id.CreateOptions{
+				MaxDepth:      maxDepth,
+				Direction:     id.DirectionBoth,
+				Filter:        filter,
+				LookUpParents: lookUpParents,
+			}
+			if childrenOnly {
+				options.Direction = id.DirectionChildren
+			}
+			if parentsOnly {
+				options.Direction = id.DirectionParents
+			}
+
+			e := id.CreateWithOptions(table, []string{}, reader, idStorageFactory(ingressDescriptor), options)
 			if e != nil {
 				fmt.Fprintln(err, e.Description) //nolint:errcheck
 				os.Exit(1)
@@ -54,6 +85,11 @@ func newCreateCommand(fullName string, err *os.File, out *os.File, in *os.File)
 			fmt.Fprintln(out, "successfully created ingress descriptor") //nolint:errcheck
 		},
 	}
+	cmd.Flags().UintVar(&maxDepth, "max-depth", 0, "keep only the tables at most this number of relations away from the start table (0 for no limit)")
+	cmd.Flags().BoolVar(&childrenOnly, "children-only", false, "follow the relations from parent to child only")
+	cmd.Flags().BoolVar(&parentsOnly, "parents-only", false, "follow the relations from child to parent only")
+	cmd.Flags().StringSliceVar(&exclude, "exclude", []string{}, "ignore the tables matching one of these patterns (glob, or regular expression between slashes)")
+	cmd.Flags().BoolVar(&lookUpParents, "lookup-parents", false, "activate the parent lookups needed to pull the parents of every pulled table")
 	cmd.SetOut(out)
 	cmd.SetErr(err)
 	cmd.SetIn(in)
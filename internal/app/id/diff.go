@@ -0,0 +1,69 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+package id
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cgi-fr/lino/pkg/id"
+	"github.com/spf13/cobra"
+)
+
+// newDiffCommand implements the cli id diff command
+func newDiffCommand(fullName string, err *os.File, out *os.File, in *os.File) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "diff [ingress descriptor a] [ingress descriptor b]",
+		Short:   "Show changes between two ingress descriptors and their plans",
+		Long:    "",
+		Example: fmt.Sprintf("  %[1]s id diff ingress-descriptor.yaml other-ingress-descriptor.yaml", fullName),
+		Args:    cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			diff, e := id.Diff(idStorageFactory(args[0]), idStorageFactory(args[1]))
+			if e != nil {
+				fmt.Fprintln(err, e.Description) //nolint:errcheck
+				os.Exit(1)
+			}
+
+			for _, change := range diff.Changes {
+				switch {
+				case change.Before == "":
+					fmt.Fprintf(out, "+ %s: %s\n", change.Path, change.After) //nolint:errcheck
+				case change.After == "":
+					fmt.Fprintf(out, "- %s: %s\n", change.Path, change.Before) //nolint:errcheck
+				default:
+					fmt.Fprintf(out, "~ %s: %s => %s\n", change.Path, change.Before, change.After) //nolint:errcheck
+				}
+			}
+
+			if len(diff.Steps) > 0 {
+				fmt.Fprintln(out, "puller plan:") //nolint:errcheck
+				for _, step := range diff.Steps {
+					if step.Added {
+						fmt.Fprintf(out, "+ %s\n", step.Step) //nolint:errcheck
+					} else {
+						fmt.Fprintf(out, "- %s\n", step.Step) //nolint:errcheck
+					}
+				}
+			}
+		},
+	}
+	cmd.SetOut(out)
+	cmd.SetErr(err)
+	cmd.SetIn(in)
+	return cmd
+}
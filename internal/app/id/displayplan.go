@@ -27,14 +27,22 @@
 
 // newDisplayPlanCommand implements the cli id display-plan command
 func newDisplayPlanCommand(fullName string, err *os.File, out *os.File, in *os.File) *cobra.Command {
+	var params []string
+
 	cmd := &cobra.Command{
 		Use:     "display-plan",
 		Short:   "Show ingress descriptor steps",
 		Long:    "",
-		Example: fmt.Sprintf("  %[1]s id display-plan", fullName),
+		Example: fmt.Sprintf("  %[1]s id display-plan\n  %[1]s id display-plan --param since=2005-06-01", fullName),
 		Args:    cobra.NoArgs,
 		Run: func(cmd *cobra.Command, args []string) {
-			result, e := id.GetPullerPlan(idStorageFactory(ingressDescriptor))
+			values, e := id.ParseParams(params)
+			if e != nil {
+				fmt.Fprintln(err, e.Description) //nolint:errcheck
+				os.Exit(1)
+			}
+
+			result, e := id.GetPullerPlan(id.WithParams(idStorageFactory(ingressDescriptor), values))
 			if e != nil {
 				fmt.Fprintln(err, e.Description) //nolint:errcheck
 				os.Exit(1)
@@ -45,6 +53,7 @@ func newDisplayPlanCommand(fullName string, err *os.File, out *os.File, in *os.F
 			}
 		},
 	}
+	cmd.Flags().StringArrayVar(&params, "param", []string{}, "value of a parameter ${key} of the ingress descriptor, as key=value")
 	cmd.SetOut(out)
 	cmd.SetErr(err)
 	cmd.SetIn(in)
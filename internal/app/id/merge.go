@@ -0,0 +1,71 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+package id
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cgi-fr/lino/pkg/id"
+	"github.com/spf13/cobra"
+)
+
+// newMergeCommand implements the cli id merge command
+func newMergeCommand(fullName string, err *os.File, out *os.File, in *os.File) *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:     "merge [base ingress descriptor] [our ingress descriptor] [their ingress descriptor]",
+		Short:   "Merge the changes of two ingress descriptors made from the same base",
+		Long:    "",
+		Example: fmt.Sprintf("  %[1]s id merge base.yaml ingress-descriptor.yaml their-ingress-descriptor.yaml", fullName),
+		Args:    cobra.ExactArgs(3),
+		Run: func(cmd *cobra.Command, args []string) {
+			if output == "" {
+				output = args[1]
+			}
+
+			conflicts, e := id.Merge(idStorageFactory(args[0]), idStorageFactory(args[1]), idStorageFactory(args[2]), idStorageFactory(output))
+			if e != nil {
+				fmt.Fprintln(err, e.Description) //nolint:errcheck
+				os.Exit(1)
+			}
+
+			if len(conflicts) > 0 {
+				for _, conflict := range conflicts {
+					fmt.Fprintf(err, "conflict on %s: base %s, ours %s, theirs %s\n", conflict.Path, orNone(conflict.Base), orNone(conflict.Ours), orNone(conflict.Theirs)) //nolint:errcheck
+				}
+				fmt.Fprintf(err, "%d conflicts, our values are kept in %s\n", len(conflicts), output) //nolint:errcheck
+				os.Exit(1)
+			}
+
+			fmt.Fprintln(out, "successfully merged ingress descriptor") //nolint:errcheck
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "", "file of the merged ingress descriptor (default our ingress descriptor)")
+	cmd.SetOut(out)
+	cmd.SetErr(err)
+	cmd.SetIn(in)
+	return cmd
+}
+
+func orNone(value string) string {
+	if value == "" {
+		return "(none)"
+	}
+	return value
+}
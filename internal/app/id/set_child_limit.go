@@ -0,0 +1,58 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package id
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/cgi-fr/lino/pkg/id"
+	"github.com/spf13/cobra"
+)
+
+// newSetChildLimitCommand implements the cli id set-child-limit command
+func newSetChildLimitCommand(fullName string, err *os.File, out *os.File, in *os.File) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-child-limit [relation] [limit]",
+		Short:   "set maximum number of children pulled per parent for relation [relation] in ingress descriptor (0 = no limit)",
+		Long:    "",
+		Example: fmt.Sprintf("  %[1]s id set-child-limit public.store 10", fullName),
+		Args:    cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			relation := args[0]
+			limit, e1 := strconv.ParseUint(args[1], 10, 32)
+			if e1 != nil {
+				fmt.Fprintln(err, "limit must be a positive integer") //nolint:errcheck
+				os.Exit(1)
+			}
+
+			e := id.SetChildLimit(relation, uint(limit), idStorageFactory(ingressDescriptor))
+			if e != nil {
+				fmt.Fprintln(err, e.Description) //nolint:errcheck
+				os.Exit(1)
+			}
+
+			fmt.Fprintf(out, "successfully update relation %s in ingress descriptor\n", relation) //nolint:errcheck
+		},
+	}
+	cmd.SetOut(out)
+	cmd.SetErr(err)
+	cmd.SetIn(in)
+	return cmd
+}
@@ -20,6 +20,8 @@
 import (
 	"fmt"
 	"os"
+	"sort"
+	"strings"
 
 	"github.com/cgi-fr/lino/pkg/id"
 	"github.com/spf13/cobra"
@@ -27,22 +29,39 @@
 
 // newShowGraphCommand implements the cli id show-graph command
 func newShowGraphCommand(fullName string, err *os.File, out *os.File, in *os.File) *cobra.Command {
+	var format string
+
 	cmd := &cobra.Command{
 		Use:     "show-graph",
 		Short:   "Show ingress descriptor graph",
 		Long:    "",
-		Example: fmt.Sprintf("  %[1]s id show-graph", fullName),
+		Example: fmt.Sprintf("  %[1]s id show-graph\n  %[1]s id show-graph --format mermaid > ingress-descriptor.mmd", fullName),
 		Args:    cobra.NoArgs,
 		Run: func(cmd *cobra.Command, args []string) {
-			e := id.Export(idStorageFactory(ingressDescriptor), idExporter)
+			exporterFactory, ok := idExporters[format]
+			if !ok {
+				formats := []string{}
+				for name := range idExporters {
+					formats = append(formats, name)
+				}
+				sort.Strings(formats)
+				fmt.Fprintf(err, "unknown format %s, expected one of %s\n", format, strings.Join(formats, ", ")) //nolint:errcheck
+				os.Exit(1)
+			}
+
+			e := id.Export(idStorageFactory(ingressDescriptor), exporterFactory(out))
 			if e != nil {
 				fmt.Fprintln(err, e.Description) //nolint:errcheck
 				os.Exit(1)
 			}
 
-			fmt.Fprintln(out, "success") //nolint:errcheck
+			// other formats are written to the standard output
+			if format == "graphviz" {
+				fmt.Fprintln(out, "success") //nolint:errcheck
+			}
 		},
 	}
+	cmd.Flags().StringVar(&format, "format", "graphviz", "graph format: graphviz (open the SVG rendered by the dot command in the browser), mermaid, plantuml or svg")
 	cmd.SetOut(out)
 	cmd.SetErr(err)
 	cmd.SetIn(in)
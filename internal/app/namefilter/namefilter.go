@@ -0,0 +1,104 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package namefilter selects database objects by name with include and exclude patterns given on the command line.
+package namefilter
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// Filter keeps the names matching one of the include patterns (or all names if there is none) and none of the
+// exclude patterns.
+type Filter struct {
+	include []matcher
+	exclude []matcher
+}
+
+type matcher func(name string) bool
+
+// New creates a filter, a pattern is a glob (case insensitive) or a regular expression between slashes (/^audit_/).
+func New(include []string, exclude []string) (*Filter, error) {
+	f := &Filter{}
+
+	for _, pattern := range include {
+		m, err := compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		f.include = append(f.include, m)
+	}
+
+	for _, pattern := range exclude {
+		m, err := compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		f.exclude = append(f.exclude, m)
+	}
+
+	return f, nil
+}
+
+// Match returns true if the name is selected by the filter. A schema-qualified name (schema.table) is selected if
+// the patterns match the qualified name or the name without schema.
+func (f *Filter) Match(name string) bool {
+	if f == nil {
+		return true
+	}
+
+	if len(f.include) > 0 && !matchAny(f.include, name) {
+		return false
+	}
+
+	return !matchAny(f.exclude, name)
+}
+
+func matchAny(matchers []matcher, name string) bool {
+	unqualified := name[strings.LastIndex(name, ".")+1:]
+
+	for _, m := range matchers {
+		if m(name) || m(unqualified) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func compile(pattern string) (matcher, error) {
+	if len(pattern) > 1 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") {
+		re, err := regexp.Compile(pattern[1 : len(pattern)-1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %s: %w", pattern, err)
+		}
+		return re.MatchString, nil
+	}
+
+	glob := strings.ToLower(pattern)
+	if _, err := path.Match(glob, ""); err != nil {
+		return nil, fmt.Errorf("invalid pattern %s: %w", pattern, err)
+	}
+
+	return func(name string) bool {
+		ok, _ := path.Match(glob, strings.ToLower(name))
+		return ok
+	}, nil
+}
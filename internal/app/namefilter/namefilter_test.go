@@ -0,0 +1,72 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package namefilter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		include []string
+		exclude []string
+		want    map[string]bool
+	}{
+		{
+			name: "no pattern",
+			want: map[string]bool{"customer": true, "sales.orders": true},
+		},
+		{
+			name:    "include glob",
+			include: []string{"cust*", "ORDER?"},
+			want:    map[string]bool{"customer": true, "CUSTOMER_ADDRESS": true, "sales.orders": true, "payment": false},
+		},
+		{
+			name:    "exclude regex",
+			exclude: []string{"/^(tmp|audit)_/"},
+			want:    map[string]bool{"customer": true, "tmp_load": false, "sales.audit_log": false},
+		},
+		{
+			name:    "include schema",
+			include: []string{"sales.*"},
+			exclude: []string{"*_bak"},
+			want:    map[string]bool{"customer": false, "sales.orders": true, "sales.orders_bak": false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := New(tt.include, tt.exclude)
+			assert.NoError(t, err)
+
+			for name, want := range tt.want {
+				assert.Equal(t, want, f.Match(name), name)
+			}
+		})
+	}
+}
+
+func TestInvalidPattern(t *testing.T) {
+	_, err := New([]string{"/(/"}, nil)
+	assert.EqualError(t, err, "invalid pattern /(/: error parsing regexp: missing closing ): `(`")
+
+	_, err = New(nil, []string{"[a-"})
+	assert.EqualError(t, err, "invalid pattern [a-: syntax error in pattern")
+}
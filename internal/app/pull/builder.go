@@ -124,8 +124,10 @@ func (b builder) buildRelation(rel id.IngressRelation) ([]pull.Relation, error)
 					Table: b.getTable(rel.Child().Name()),
 					Keys:  relyaml.Child.Keys,
 				},
-				Where:  rel.WhereChild(),
-				Select: rel.SelectChild(),
+				Where:   rel.WhereChild(),
+				Select:  rel.SelectChild(),
+				OrderBy: rel.OrderChild(),
+				Limit:   rel.LimitChild(),
 			}
 		}
 		b.exrmap[name] = exrel
@@ -147,8 +149,9 @@ func (b builder) buildRelation(rel id.IngressRelation) ([]pull.Relation, error)
 					Table: b.getTable(rel.Child().Name()),
 					Keys:  relyaml.Child.Keys,
 				},
-				Where:  rel.WhereParent(),
-				Select: rel.SelectParent(),
+				Where:   rel.WhereParent(),
+				Select:  rel.SelectParent(),
+				OrderBy: rel.OrderParent(),
 			}
 		}
 		b.exrmap[name] = exrel
@@ -44,9 +44,13 @@
 	pullExporterFactory  func(io.Writer) pull.RowExporter
 	rowReaderFactory     func(io.ReadCloser) pull.RowReader
 	keyStoreFactory      func(io.ReadCloser, []string) (pull.KeyStore, error)
+	fileStoreFactory     func(string) pull.FileStore
 )
 
-var traceListener pull.TraceListener
+var (
+	traceListener pull.TraceListener
+	observer      pull.Observer
+)
 
 // Inject dependencies
 func Inject(
@@ -59,6 +63,8 @@ func Inject(
 	rrf func(io.ReadCloser) pull.RowReader,
 	ksf func(io.ReadCloser, []string) (pull.KeyStore, error),
 	tl pull.TraceListener,
+	fsf func(string) pull.FileStore,
+	obs pull.Observer,
 ) {
 	dataconnectorStorage = dbas
 	relStorage = rs
@@ -69,6 +75,8 @@ func Inject(
 	rowReaderFactory = rrf
 	keyStoreFactory = ksf
 	traceListener = tl
+	fileStoreFactory = fsf
+	observer = obs
 }
 
 // NewCommand implements the cli pull command
@@ -85,6 +93,11 @@ func NewCommand(fullName string, err *os.File, out *os.File, in *os.File) *cobra
 	var diagnostic bool
 	var filters pull.RowReader
 	var parallel uint
+	var multiDescriptor string
+	var explainFormat string
+	var watch bool
+	var filesDir string
+	var params []string
 
 	cmd := &cobra.Command{
 		Use:     "pull [DB Alias Name]",
@@ -104,6 +117,11 @@ func NewCommand(fullName string, err *os.File, out *os.File, in *os.File) *cobra
 				Str("table", table).
 				Str("where", where).
 				Uint("parallel", parallel).
+				Str("multi-descriptor", multiDescriptor).
+				Str("explain", explainFormat).
+				Bool("watch", watch).
+				Str("files-dir", filesDir).
+				Strs("param", params).
 				Msg("Pull mode")
 		},
 		Run: func(cmd *cobra.Command, args []string) {
@@ -118,7 +136,20 @@ func NewCommand(fullName string, err *os.File, out *os.File, in *os.File) *cobra
 				os.Exit(1)
 			}
 
-			plan, start, startSelect, e2 := getPullerPlan(idStorageFactory(table, ingressDescriptor))
+			paramValues, e3 := id.ParseParams(params)
+			if e3 != nil {
+				fmt.Fprintln(err, e3.Description) //nolint:errcheck
+				os.Exit(1)
+			}
+
+			if multiDescriptor != "" {
+				pullMulti(datasource, multiDescriptor, ingressDescriptor, paramValues, diagnostic, watch, filesDir, out, err)
+				logStats(startTime)
+
+				return
+			}
+
+			plan, start, startSelect, startOrder, e2 := getPullerPlan(id.WithParams(idStorageFactory(table, ingressDescriptor), paramValues))
 			if e2 != nil {
 				fmt.Fprintln(err, e2.Error()) //nolint:errcheck
 				os.Exit(1)
@@ -173,19 +204,25 @@ func NewCommand(fullName string, err *os.File, out *os.File, in *os.File) *cobra
 				Values:   row,
 				Where:    where,
 				Distinct: distinct,
+				OrderBy:  startOrder,
+			}
+
+			if explainFormat != "" {
+				if e3 := explain(datasource, plan, start, filter, startSelect, explainFormat, out); e3 != nil {
+					fmt.Fprintln(err, e3.Error()) //nolint:errcheck
+					os.Exit(1)
+				}
+
+				return
 			}
 
-			puller := pull.NewPullerParallel(plan, datasource, pullExporterFactory(out), tracer, parallel)
+			puller := pull.NewPullerParallel(plan, datasource, pullExporterFactory(out), tracer, fileStoreFactory(filesDir), parallel, observers(watch)...)
 			if e3 := puller.Pull(start, filter, startSelect, filters, filtersEx); e3 != nil {
 				log.Fatal().AnErr("error", e3).Msg("Fatal error stop the pull command")
 				os.Exit(1)
 			}
 
-			duration := time.Since(startTime)
-			over.MDC().Set("duration", duration)
-			stats := pull.Compute()
-			pull.SetDuration(duration)
-			over.MDC().Set("stats", stats.ToJSON())
+			logStats(startTime)
 		},
 	}
 	cmd.Flags().UintVarP(&limit, "limit", "l", 1, "limit the number of results")
@@ -197,13 +234,62 @@ func NewCommand(fullName string, err *os.File, out *os.File, in *os.File) *cobra
 	cmd.Flags().StringVarP(&table, "table", "t", "", "pull content of table without relations instead of ingress descriptor definition")
 	cmd.Flags().StringVarP(&where, "where", "w", "", "Advanced SQL where clause to filter")
 	cmd.Flags().StringVarP(&ingressDescriptor, "ingress-descriptor", "i", "ingress-descriptor.yaml", "pull content using ingress descriptor definition")
+	cmd.Flags().StringArrayVar(&params, "param", []string{}, "value of a parameter ${key} of the ingress descriptor, as key=value")
 	cmd.Flags().UintVarP(&parallel, "parallel", "p", 1, "number of parallel workers")
+	cmd.Flags().StringVarP(&multiDescriptor, "multi-descriptor", "m", "", "pull several start tables declared in a multi-descriptor file, each row is exported once")
+	cmd.Flags().StringVar(&explainFormat, "explain", "", "print the SQL plan (text or json) without pulling data")
+	cmd.Flags().Lookup("explain").NoOptDefVal = "text"
+	cmd.Flags().BoolVar(&watch, "watch", false, "watch statistics about pulled lines")
+	cmd.Flags().StringVar(&filesDir, "files-dir", "files", "directory where columns with the file export format are written")
+	cmd.MarkFlagsMutuallyExclusive("multi-descriptor", "table")
+	cmd.MarkFlagsMutuallyExclusive("multi-descriptor", "explain")
+	cmd.MarkFlagsMutuallyExclusive("watch", "explain")
+	cmd.MarkFlagsMutuallyExclusive("multi-descriptor", "filter-from-file")
+	cmd.MarkFlagsMutuallyExclusive("multi-descriptor", "exclude-from-file")
+	cmd.MarkFlagsMutuallyExclusive("multi-descriptor", "limit")
+	cmd.MarkFlagsMutuallyExclusive("multi-descriptor", "where")
+	cmd.MarkFlagsMutuallyExclusive("multi-descriptor", "parallel")
 	cmd.SetOut(out)
 	cmd.SetErr(err)
 	cmd.SetIn(in)
 	return cmd
 }
 
+func pullMulti(datasource pull.DataSource, multiDescriptor string, ingressDescriptor string, params map[string]string, diagnostic bool, watch bool, filesDir string, out io.Writer, err io.Writer) {
+	starts, e1 := getStartPoints(multiDescriptor, ingressDescriptor, params)
+	if e1 != nil {
+		fmt.Fprintln(err, e1.Error()) //nolint:errcheck
+		os.Exit(1)
+	}
+
+	var tracer pull.TraceListener = pull.NoTraceListener{}
+	if diagnostic {
+		tracer = traceListener
+	}
+
+	puller := pull.NewMultiPuller(datasource, pullExporterFactory(out), tracer, fileStoreFactory(filesDir), observers(watch)...)
+	if e2 := puller.Pull(starts); e2 != nil {
+		log.Fatal().AnErr("error", e2).Msg("Fatal error stop the pull command")
+		os.Exit(1)
+	}
+}
+
+func observers(watch bool) []pull.Observer {
+	if watch && observer != nil {
+		return []pull.Observer{observer}
+	}
+
+	return []pull.Observer{}
+}
+
+func logStats(startTime time.Time) {
+	duration := time.Since(startTime)
+	over.MDC().Set("duration", duration)
+	stats := pull.Compute()
+	pull.SetDuration(duration)
+	over.MDC().Set("stats", stats.ToJSON())
+}
+
 func getDataSource(dataconnectorName string, out io.Writer) (pull.DataSource, error) {
 	alias, e1 := dataconnector.Get(dataconnectorStorage, dataconnectorName)
 	if e1 != nil {
@@ -223,26 +309,26 @@ func getDataSource(dataconnectorName string, out io.Writer) (pull.DataSource, er
 	return datasourceFactory.New(u.URL.String(), alias.Schema), nil
 }
 
-func getPullerPlan(idStorage id.Storage) (pull.Plan, pull.Table, []string, error) {
+func getPullerPlan(idStorage id.Storage) (pull.Plan, pull.Table, []string, []string, error) {
 	pp, err1 := id.GetPullerPlan(idStorage)
 	if err1 != nil {
-		return pull.Plan{}, pull.Table{}, []string{}, err1
+		return pull.Plan{}, pull.Table{}, []string{}, []string{}, err1
 	}
 
 	relations, err2 := relStorage.List()
 	if err2 != nil {
-		return pull.Plan{}, pull.Table{}, []string{}, err2
+		return pull.Plan{}, pull.Table{}, []string{}, []string{}, err2
 	}
 
 	tables, err3 := tabStorage.List()
 	if err3 != nil {
-		return pull.Plan{}, pull.Table{}, []string{}, err3
+		return pull.Plan{}, pull.Table{}, []string{}, []string{}, err3
 	}
 
 	builder := newBuilder(pp, relations, tables)
 	plan, startTable, err4 := builder.plan()
 	if err4 != nil {
-		return pull.Plan{}, pull.Table{}, []string{}, err4
+		return pull.Plan{}, pull.Table{}, []string{}, []string{}, err4
 	}
 
 	// Check startTable existe in table.yaml
@@ -257,8 +343,8 @@ func getPullerPlan(idStorage id.Storage) (pull.Plan, pull.Table, []string, error
 
 	if !tableExiste {
 		err5 := fmt.Errorf("table '%s' does not exist in table.yaml", string(startTable.Name))
-		return pull.Plan{}, pull.Table{}, []string{}, err5
+		return pull.Plan{}, pull.Table{}, []string{}, []string{}, err5
 	}
 
-	return plan, startTable, pp.Select(), nil
+	return plan, startTable, pp.Select(), pp.OrderBy(), nil
 }
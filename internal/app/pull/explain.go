@@ -0,0 +1,88 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package pull
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cgi-fr/lino/pkg/pull"
+)
+
+func explain(datasource pull.DataSource, plan pull.Plan, start pull.Table, filter pull.Filter, startSelect []string, format string, out io.Writer) error {
+	explainer, ok := datasource.(pull.QueryExplainer)
+	if !ok {
+		return fmt.Errorf("explain is not supported by this data connector")
+	}
+
+	queries := pull.Explain(plan, start, filter, startSelect, explainer)
+
+	switch format {
+	case "json":
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(queries)
+	case "text":
+		for _, query := range queries {
+			writeExplainedQuery(out, query)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unknown explain format %s, must be text or json", format)
+	}
+}
+
+func writeExplainedQuery(out io.Writer, query pull.ExplainedQuery) {
+	if query.Relation == "" {
+		fmt.Fprintf(out, "step %d - read %s\n", query.Step, query.Table) //nolint:errcheck
+	} else {
+		fmt.Fprintf(out, "step %d - read %s following %s relationship from %s (cardinality %s)\n", query.Step, query.Table, query.Relation, query.From, query.Cardinality) //nolint:errcheck
+	}
+
+	if len(query.Columns) > 0 {
+		fmt.Fprintf(out, "  columns : %s\n", strings.Join(query.Columns, ", ")) //nolint:errcheck
+	} else {
+		fmt.Fprintln(out, "  columns : *") //nolint:errcheck
+	}
+
+	if query.Where != "" {
+		fmt.Fprintf(out, "  where   : %s\n", query.Where) //nolint:errcheck
+	}
+
+	if len(query.OrderBy) > 0 {
+		fmt.Fprintf(out, "  order   : %s\n", strings.Join(query.OrderBy, ", ")) //nolint:errcheck
+	}
+
+	if query.Limit > 0 {
+		fmt.Fprintf(out, "  limit   : %d\n", query.Limit) //nolint:errcheck
+	}
+
+	for i, key := range query.Keys {
+		if i < len(query.BoundFrom) {
+			fmt.Fprintf(out, "  bind    : %s <- %s\n", key, query.BoundFrom[i]) //nolint:errcheck
+		} else {
+			fmt.Fprintf(out, "  bind    : %s\n", key) //nolint:errcheck
+		}
+	}
+
+	fmt.Fprintf(out, "  sql     : %s\n", query.SQL) //nolint:errcheck
+}
@@ -23,6 +23,7 @@
 	"strconv"
 	"strings"
 
+	"github.com/cgi-fr/lino/pkg/id"
 	"github.com/cgi-fr/lino/pkg/pull"
 	"github.com/gorilla/mux"
 	"github.com/rs/zerolog/log"
@@ -127,7 +128,25 @@ func HandlerFactory(ingressDescriptor string) func(w http.ResponseWriter, r *htt
 			return
 		}
 
-		plan, start, startSelect, e2 := getPullerPlan(idStorageFactory(query.Get("table"), ingressDescriptor))
+		// CWE-117 : sanitize user input
+		paramValues := []string{}
+		for _, param := range query["param"] {
+			paramValues = append(paramValues, strings.ReplaceAll(strings.ReplaceAll(param, "\n", ""), "\r", ""))
+		}
+
+		params, e1 := id.ParseParams(paramValues)
+		if e1 != nil {
+			log.Error().Str("error", e1.Description).Msg("")
+			w.WriteHeader(http.StatusBadRequest)
+			_, ew := w.Write([]byte("{\"error\": \"" + e1.Description + "\"}"))
+			if ew != nil {
+				log.Error().Err(ew).Msg("Write failed")
+				return
+			}
+			return
+		}
+
+		plan, start, startSelect, startOrder, e2 := getPullerPlan(id.WithParams(idStorageFactory(query.Get("table"), ingressDescriptor), params))
 		if e2 != nil {
 			log.Error().Err(e2).Msg("")
 			w.WriteHeader(http.StatusInternalServerError)
@@ -140,9 +159,9 @@ func HandlerFactory(ingressDescriptor string) func(w http.ResponseWriter, r *htt
 		}
 
 		pullExporter := pullExporterFactory(w)
-		puller := pull.NewPuller(plan, datasource, pullExporter, pull.NoTraceListener{})
+		puller := pull.NewPuller(plan, datasource, pullExporter, pull.NoTraceListener{}, nil)
 
-		e3 := puller.Pull(start, pull.Filter{Limit: limit, Values: filter, Where: where, Distinct: distinct}, startSelect, nil, nil)
+		e3 := puller.Pull(start, pull.Filter{Limit: limit, Values: filter, Where: where, Distinct: distinct, OrderBy: startOrder}, startSelect, nil, nil)
 		if e3 != nil {
 			log.Error().Err(e3).Msg("")
 			w.WriteHeader(http.StatusInternalServerError)
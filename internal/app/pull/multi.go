@@ -0,0 +1,110 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package pull
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cgi-fr/lino/pkg/id"
+	"github.com/cgi-fr/lino/pkg/pull"
+	"gopkg.in/yaml.v3"
+)
+
+// MultiDescriptorVersion is the supported version of the multi-descriptor file.
+const MultiDescriptorVersion string = "v1"
+
+// YAMLMultiDescriptor lists several start points to pull in a single session.
+type YAMLMultiDescriptor struct {
+	Version string           `yaml:"version"`
+	Starts  []YAMLStartPoint `yaml:"starts"`
+}
+
+// YAMLStartPoint declares a start table with its own filter and limit.
+type YAMLStartPoint struct {
+	IngressDescriptor string            `yaml:"ingressDescriptor,omitempty"`
+	Table             string            `yaml:"table,omitempty"`
+	Filter            map[string]string `yaml:"filter,omitempty"`
+	Where             string            `yaml:"where,omitempty"`
+	Limit             uint              `yaml:"limit,omitempty"`
+	Distinct          bool              `yaml:"distinct,omitempty"`
+}
+
+func readMultiDescriptor(filename string) (*YAMLMultiDescriptor, error) {
+	dat, err := os.ReadFile(filename) //nolint:gosec
+	if err != nil {
+		return nil, err
+	}
+
+	structure := &YAMLMultiDescriptor{}
+	if err := yaml.Unmarshal(dat, structure); err != nil {
+		return nil, err
+	}
+
+	if structure.Version != MultiDescriptorVersion {
+		return nil, fmt.Errorf("invalid version in %s (%s)", filename, structure.Version)
+	}
+
+	if len(structure.Starts) == 0 {
+		return nil, fmt.Errorf("no start declared in %s", filename)
+	}
+
+	return structure, nil
+}
+
+// getStartPoints computes the puller plan of each start declared in the multi-descriptor file.
+func getStartPoints(filename string, defaultIngressDescriptor string, params map[string]string) ([]pull.StartPoint, error) {
+	md, err := readMultiDescriptor(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]pull.StartPoint, 0, len(md.Starts))
+
+	for _, sp := range md.Starts {
+		ingressDescriptor := sp.IngressDescriptor
+		if ingressDescriptor == "" {
+			ingressDescriptor = defaultIngressDescriptor
+		}
+
+		plan, start, startSelect, startOrder, err := getPullerPlan(id.WithParams(idStorageFactory(sp.Table, ingressDescriptor), params))
+		if err != nil {
+			return nil, err
+		}
+
+		values := pull.Row{}
+		for column, value := range sp.Filter {
+			values[column] = value
+		}
+
+		result = append(result, pull.StartPoint{
+			Plan:  plan,
+			Table: start,
+			Filter: pull.Filter{
+				Limit:    sp.Limit,
+				Values:   values,
+				Where:    sp.Where,
+				Distinct: sp.Distinct,
+				OrderBy:  startOrder,
+			},
+			Select: startSelect,
+		})
+	}
+
+	return result, nil
+}
@@ -21,6 +21,7 @@
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -72,16 +73,48 @@ func Inject(
 }
 
 // parseArgument get dataconnector and mode from args
-func parseArguments(args []string) (string, push.Mode) {
-	dcDestination := args[0]
-	mode, _ := push.ParseMode("insert")
+// destinationArgument is a dataconnector given on the command line, with the mode to push to it
+type destinationArgument struct {
+	dataconnector string
+	mode          push.Mode
+}
+
+// parseArguments reads the optional default mode followed by the dataconnector names, each name can be
+// suffixed by its own mode (e.g. dest1 dest2:upsert)
+func parseArguments(args []string) ([]destinationArgument, *push.Error) {
+	mode := push.Insert
+	names := args
+
+	if len(args) > 1 {
+		if defaultMode, err := push.ParseMode(args[0]); err == nil {
+			mode = defaultMode
+			names = args[1:]
+		}
+	}
+
+	destinations := make([]destinationArgument, 0, len(names))
+	seen := map[string]bool{}
+
+	for _, name := range names {
+		destination := destinationArgument{dataconnector: name, mode: mode}
+
+		if i := strings.LastIndex(name, ":"); i > 0 {
+			destinationMode, err := push.ParseMode(name[i+1:])
+			if err != nil {
+				return nil, err
+			}
+			destination = destinationArgument{dataconnector: name[:i], mode: destinationMode}
+		}
+
+		if seen[destination.dataconnector] {
+			return nil, &push.Error{Description: fmt.Sprintf("dataconnector %s is given more than once", destination.dataconnector)}
+		}
+		seen[destination.dataconnector] = true
 
-	if len(args) == 2 {
-		dcDestination = args[1]
-		mode, _ = push.ParseMode(args[0])
+		destinations = append(destinations, destination)
 	}
 
-	return dcDestination, mode
+	return destinations, nil
 }
 
 // NewCommand implements the cli pull command
@@ -90,10 +123,12 @@ func NewCommand(fullName string, err *os.File, out *os.File, in *os.File) *cobra
 		commitSize         uint
 		disableConstraints bool
 		catchErrors        string
+		catchErrorsFormat  string
 		table              string
 		ingressDescriptor  string
 		rowExporter        push.RowWriter
 		pkTranslations     map[string]string
+		generatedKeys      map[string]string
 		usingPkField       string
 		whereClause        string
 		savepoint          string
@@ -101,35 +136,53 @@ func NewCommand(fullName string, err *os.File, out *os.File, in *os.File) *cobra
 		watch              bool
 		logSQLTo           string
 		commitTimeout      time.Duration
+		bulk               bool
+		parallel           uint
+		toScript           string
+		diff               bool
+		journal            string
+		retryMaxAttempts   uint
+		retryBackoff       time.Duration
+		retryMaxBackoff    time.Duration
+		retryReconnect     bool
+		onConflict         string
+		continueOnDestErr  bool
 	)
 
 	cmd := &cobra.Command{
-		Use:     "push {<truncate>|<insert>|<update>|<delete>|<upsert>} [Data Connector Name]",
-		Short:   "Push data to a database with a pushing mode (insert by default)",
+		Use:     "push {<truncate>|<insert>|<update>|<delete>|<upsert>} [Data Connector Name[:mode]]...",
+		Short:   "Push data to one or several databases with a pushing mode (insert by default)",
 		Long:    "",
-		Example: fmt.Sprintf("  %[1]s push truncate dstdatabase\n  %[1]s push dstdatabase", fullName),
+		Example: fmt.Sprintf("  %[1]s push truncate dstdatabase\n  %[1]s push dstdatabase\n  %[1]s push truncate staging qualif:upsert", fullName),
 		Args: func(cmd *cobra.Command, args []string) error {
-			if len(args) == 1 {
-				return nil
+			if len(args) == 0 {
+				return fmt.Errorf("requires at least 1 arg, received 0")
 			}
-			if len(args) == 2 {
-				if _, err := push.ParseMode(args[0]); err != nil {
-					return err
-				}
-				return nil
+			if _, err := parseArguments(args); err != nil {
+				return err
 			}
-			return fmt.Errorf("accepts 1 or 2 args, received %d", len(args))
+			return nil
 		},
 		PreRun: func(cmd *cobra.Command, args []string) {
-			dcDestination, mode := parseArguments(args)
+			destinationArgs, _ := parseArguments(args)
+
+			dataconnectors := []string{}
+			modes := []string{}
+			for _, destinationArg := range destinationArgs {
+				dataconnectors = append(dataconnectors, destinationArg.dataconnector)
+				modes = append(modes, destinationArg.mode.String())
+			}
 
 			log.Info().
-				Str("dataconnector", dcDestination).
-				Str("mode", mode.String()).
+				Str("dataconnector", strings.Join(dataconnectors, ",")).
+				Str("mode", strings.Join(modes, ",")).
 				Uint("commitSize", commitSize).
 				Bool("disable-constraints", disableConstraints).
 				Str("catch-errors", catchErrors).
 				Str("table", table).
+				Bool("bulk", bulk).
+				Uint("parallel", parallel).
+				Uint("retry-max-attempts", retryMaxAttempts).
 				Msg("Push mode")
 		},
 		Run: func(cmd *cobra.Command, args []string) {
@@ -138,15 +191,125 @@ func NewCommand(fullName string, err *os.File, out *os.File, in *os.File) *cobra
 
 			startTime := time.Now()
 
-			dcDestination, mode := parseArguments(args)
+			destinationArgs, _ := parseArguments(args)
+			dcDestination, mode := destinationArgs[0].dataconnector, destinationArgs[0].mode
+			fanOut := len(destinationArgs) > 1
+
+			if fanOut {
+				if e := checkFanOutFlags(parallel, toScript, diff, journal, savepoint, logSQLTo, generatedKeys); e != nil {
+					fmt.Fprintln(err, e.Error()) //nolint:errcheck
+					os.Exit(1)
+				}
+			}
+
+			if toScript != "" {
+				if e := checkReadOnlyFlags("--to-script", parallel, bulk, logSQLTo, generatedKeys); e != nil {
+					fmt.Fprintln(err, e.Error()) //nolint:errcheck
+					os.Exit(1)
+				}
+			}
+
+			if diff {
+				if e := checkReadOnlyFlags("--plan", parallel, bulk, logSQLTo, generatedKeys); e != nil {
+					fmt.Fprintln(err, e.Error()) //nolint:errcheck
+					os.Exit(1)
+				}
+			}
 
-			datadestination, e1 := getDataDestination(dcDestination)
+			var datadestination push.DataDestination
+			var e1 *push.Error
+			if diff {
+				datadestination, e1 = getDiffDataDestination(dcDestination, rowExporterFactory(out))
+			} else if toScript != "" {
+				scriptFile, e := os.Create(toScript) //nolint:gosec
+				if e != nil {
+					fmt.Fprintln(err, e.Error()) //nolint:errcheck
+					os.Exit(1)
+				}
+				defer scriptFile.Close() //nolint:errcheck
+				datadestination, e1 = getScriptDataDestination(dcDestination, scriptFile)
+			} else {
+				datadestination, e1 = getDataDestination(dcDestination)
+			}
 			if e1 != nil {
 				fmt.Fprintln(err, e1.Error()) //nolint:errcheck
 				os.Exit(1)
 			}
 
+			destinations := []push.DataDestination{datadestination}
+			modes := []push.Mode{mode}
+			for _, destinationArg := range destinationArgs[1:] {
+				target, e := getDataDestination(destinationArg.dataconnector)
+				if e != nil {
+					fmt.Fprintln(err, e.Error()) //nolint:errcheck
+					os.Exit(1)
+				}
+				destinations = append(destinations, target)
+				modes = append(modes, destinationArg.mode)
+			}
+			for i := uint(1); i < parallel; i++ {
+				worker, e := getDataDestination(dcDestination)
+				if e != nil {
+					fmt.Fprintln(err, e.Error()) //nolint:errcheck
+					os.Exit(1)
+				}
+				destinations = append(destinations, worker)
+				modes = append(modes, mode)
+			}
+
+			if bulk {
+				for i, destination := range destinations {
+					if e := enableBulk(destination, modes[i]); e != nil {
+						fmt.Fprintln(err, e.Error()) //nolint:errcheck
+						os.Exit(1)
+					}
+				}
+			}
+
+			if onConflict != "" {
+				for i, destination := range destinations {
+					if e := setConflictPolicy(destination, modes[i], onConflict, bulk); e != nil {
+						fmt.Fprintln(err, e.Error()) //nolint:errcheck
+						os.Exit(1)
+					}
+				}
+			}
+
+			if journal != "" {
+				if parallel > 1 || toScript != "" || diff {
+					fmt.Fprintln(err, "flag --journal can not be used with flags --parallel, --to-script or --plan") //nolint:errcheck
+					os.Exit(1)
+				}
+				journalDestination, ok := datadestination.(push.JournalDataDestination)
+				if !ok {
+					fmt.Fprintln(err, "journal is not supported by this datadestination") //nolint:errcheck
+					os.Exit(1)
+				}
+				journalFile, e := os.Create(journal) //nolint:gosec
+				if e != nil {
+					fmt.Fprintln(err, e.Error()) //nolint:errcheck
+					os.Exit(1)
+				}
+				defer journalFile.Close() //nolint:errcheck
+				journalDestination.SetJournal(rowExporterFactory(journalFile))
+			}
+
+			if retryMaxAttempts > 1 && len(generatedKeys) > 0 {
+				fmt.Fprintln(err, "flag --retry-max-attempts can not be used with flag --generated-key") //nolint:errcheck
+				os.Exit(1)
+			}
+			retry := push.RetryPolicy{
+				MaxAttempts: retryMaxAttempts,
+				Backoff:     retryBackoff,
+				MaxBackoff:  retryMaxBackoff,
+				Reconnect:   retryReconnect,
+			}
+
 			if logSQLTo != "" {
+				if parallel > 1 {
+					fmt.Fprintln(err, "flag --log-sql can not be used with parallel workers") //nolint:errcheck
+					os.Exit(1)
+				}
 				if e := datadestination.OpenSQLLogger(logSQLTo); e != nil {
 					log.Warn().Err(e).Msg("error while opening SQL logger")
 				}
@@ -159,34 +322,81 @@ func NewCommand(fullName string, err *os.File, out *os.File, in *os.File) *cobra
 			}
 			log.Debug().Msg(fmt.Sprintf("call Push with mode %s", mode))
 
-			if catchErrors != "" {
-				errorFile, e4 := os.Create(catchErrors) //nolint:gosec
+			rowExporters := make([]push.RowWriter, len(destinationArgs))
+			for i, destinationArg := range destinationArgs {
+				if catchErrors == "" {
+					rowExporters[i] = push.NoErrorCaptureRowWriter{}
+					continue
+				}
+				filename := catchErrors
+				if fanOut {
+					filename = catchErrorsFilename(catchErrors, destinationArg.dataconnector)
+				}
+				errorFile, e4 := os.Create(filename) //nolint:gosec
 				if e4 != nil {
 					fmt.Fprintln(err, e4.Error()) //nolint:errcheck
 					os.Exit(4)
 				}
 				defer errorFile.Close() //nolint:errcheck
-				rowExporter = rowExporterFactory(errorFile)
-			} else {
-				rowExporter = push.NoErrorCaptureRowWriter{}
+				rowExporters[i] = rowExporterFactory(errorFile)
+				if catchErrorsFormat == "envelope" {
+					rowExporters[i] = push.NewEnvelopeRowWriter(rowExporters[i])
+				} else if catchErrorsFormat != "row" {
+					fmt.Fprintf(err, "invalid value %s for flag --catch-errors-format, expected row or envelope\n", catchErrorsFormat) //nolint:errcheck
+					os.Exit(1)
+				}
 			}
+			rowExporter = rowExporters[0]
 
 			if err := loadTranslator(pkTranslations); err != nil {
 				log.Fatal().AnErr("error", err).Msg("Fatal error stop the push command")
 				os.Exit(1)
 			}
 
+			if err := declareGeneratedKeys(generatedKeys, mode, bulk); err != nil {
+				log.Fatal().AnErr("error", err).Msg("Fatal error stop the push command")
+				os.Exit(1)
+			}
+
 			observers := []push.Observer{}
 			if watch {
 				observers = append(observers, observer)
 			}
 
-			e3 := push.Push(rowIteratorFactory(in), datadestination, plan, mode, commitSize, commitTimeout, disableConstraints, rowExporter, translator, usingPkField, whereClause, savepoint, autoTruncate, observers...)
+			var e3 *push.Error
+			if fanOut {
+				targets := make([]push.Target, len(destinationArgs))
+				for i, destinationArg := range destinationArgs {
+					targets[i] = push.Target{
+						Name:        destinationArg.dataconnector,
+						Destination: destinations[i],
+						Mode:        modes[i],
+						CatchError:  rowExporters[i],
+					}
+				}
+				e3 = push.PushFanOut(rowIteratorFactory(in), targets, plan, commitSize, commitTimeout, disableConstraints, translator, usingPkField, whereClause, autoTruncate, continueOnDestErr, retry, observers...)
+			} else if parallel > 1 {
+				var coordinator push.DataDestination
+				if mode == push.Truncate || disableConstraints {
+					if coordinator, e3 = getDataDestination(dcDestination); e3 != nil {
+						fmt.Fprintln(err, e3.Error()) //nolint:errcheck
+						os.Exit(1)
+					}
+				}
+				e3 = push.PushParallel(rowIteratorFactory(in), destinations, coordinator, plan, mode, commitSize, commitTimeout, disableConstraints, rowExporter, translator, usingPkField, whereClause, savepoint, autoTruncate, retry, observers...)
+			} else {
+				e3 = push.Push(rowIteratorFactory(in), datadestination, plan, mode, commitSize, commitTimeout, disableConstraints, rowExporter, translator, usingPkField, whereClause, savepoint, autoTruncate, retry, observers...)
+			}
 			if e3 != nil {
 				log.Fatal().AnErr("error", e3).Msg("Fatal error stop the push command")
 				os.Exit(1)
 			}
 
+			if err := exportGeneratedKeys(generatedKeys); err != nil {
+				log.Fatal().AnErr("error", err).Msg("Fatal error stop the push command")
+				os.Exit(1)
+			}
+
 			duration := time.Since(startTime)
 			over.MDC().Set("duration", duration)
 			stats := push.Compute()
@@ -198,18 +408,33 @@ func NewCommand(fullName string, err *os.File, out *os.File, in *os.File) *cobra
 	cmd.Flags().DurationVar(&commitTimeout, "commit-timeout", 0, "Commit timeout (e.g. 5s, 1m). If set, a commit is triggered if no new row is received within this duration.")
 	cmd.Flags().BoolVarP(&disableConstraints, "disable-constraints", "d", false, "Disable constraint during push")
 	cmd.Flags().StringVarP(&catchErrors, "catch-errors", "e", "", "Catch errors and write line in file")
+	cmd.Flags().StringVar(&catchErrorsFormat, "catch-errors-format", "row", "Format of caught errors: row (the rejected line) or envelope (the rejected line with the table, mode, error code, constraint, message and line number)")
 	cmd.Flags().StringVarP(&table, "table", "t", "", "Table to writes json")
 	cmd.Flags().StringVarP(&ingressDescriptor, "ingress-descriptor", "i", "ingress-descriptor.yaml", "Ingress descriptor filename")
 	cmd.Flags().StringToStringVar(&pkTranslations, "pk-translation", map[string]string{}, "list of dictionaries old value / new value for primary key update")
+	cmd.Flags().StringToStringVar(&generatedKeys, "generated-key", map[string]string{}, "list of primary keys generated by the database on insert, with the file to export generated values to (table.column=file)")
 	cmd.Flags().StringVar(&usingPkField, "using-pk-field", "__usingpk__", "Name of the data field that can be used as pk for update queries")
 	cmd.Flags().StringVar(&savepoint, "savepoint", "", "Name of a file to write primary keys of effectively processed lines (commit to database)")
 	cmd.Flags().BoolVarP(&autoTruncate, "autotruncate", "a", false, "Automatically truncate values to the maximum length defined in table.yaml")
 	cmd.Flags().BoolVarP(&watch, "watch", "w", false, "watch statistics about pushed lines")
 	cmd.Flags().StringVarP(&logSQLTo, "log-sql", "l", "", "Log SQL requests and data to specified folder (1 file per table)")
 	cmd.Flags().StringVarP(&whereClause, "where", "W", "", "WHERE clause to add to the update query")
+	cmd.Flags().UintVarP(&parallel, "parallel", "p", 1, "Number of parallel workers, each worker has its own connection and transaction")
+	cmd.Flags().StringVar(&toScript, "to-script", "", "Write the SQL statements with literal values in this file instead of executing them, no connection to the database is opened")
+	cmd.Flags().StringVar(&journal, "journal", "", "Record the prior state of modified rows in this file, to revert the push with the undo command")
+	cmd.Flags().BoolVar(&diff, "plan", false, "Print the changes that the push would make (insert, update with old and new values, unchanged, delete) as JSON lines, nothing is written in the database")
+	cmd.MarkFlagsMutuallyExclusive("plan", "to-script")
+	cmd.Flags().UintVar(&retryMaxAttempts, "retry-max-attempts", 1, "Maximum number of attempts of a commit batch failing with a transient error (deadlock, lock timeout, lost connection), 1 disables retries")
+	cmd.Flags().DurationVar(&retryBackoff, "retry-backoff", time.Second, "Wait before the first retry of a commit batch, doubled on each following retry")
+	cmd.Flags().DurationVar(&retryMaxBackoff, "retry-max-backoff", time.Minute, "Maximum wait between two retries of a commit batch")
+	cmd.Flags().BoolVar(&retryReconnect, "retry-reconnect", false, "Open a new connection to the database before retrying a commit batch")
+	cmd.Flags().StringVar(&onConflict, "on-conflict", "", "Policy when a row with the same primary key exists (skip, error, overwrite, overwrite-non-null, keep-newer), default is skip in insert and truncate modes and overwrite in upsert mode")
+	cmd.Flags().BoolVar(&continueOnDestErr, "continue-on-destination-error", false, "When pushing to several dataconnectors, keep pushing to the others if one of them fails (the push still ends in error)")
+	cmd.Flags().BoolVar(&bulk, "bulk", false, "Load rows in bulk with the native mechanism of the database (insert and truncate modes only)")
 	cmd.SetOut(out)
 	cmd.SetErr(err)
 	cmd.SetIn(in)
+	cmd.AddCommand(newUndoCommand(fullName, err, out, in))
 	return cmd
 }
 
@@ -254,6 +479,130 @@ func loadTranslator(pkTranslations map[string]string) error {
 	return nil
 }
 
+func parseKey(key string) (push.Key, error) {
+	tableAndColumn := strings.SplitN(key, ".", 2)
+	if len(tableAndColumn) != 2 {
+		return push.Key{}, fmt.Errorf("invalid key %s, expected table.column", key)
+	}
+	return push.Key{TableName: tableAndColumn[0], ColumnName: tableAndColumn[1]}, nil
+}
+
+func declareGeneratedKeys(generatedKeys map[string]string, mode push.Mode, bulk bool) error {
+	if len(generatedKeys) == 0 {
+		return nil
+	}
+
+	if mode != push.Insert && mode != push.Truncate {
+		return fmt.Errorf("generated keys are not available with mode %s", mode)
+	}
+
+	if bulk {
+		return fmt.Errorf("generated keys can't be read back in bulk mode")
+	}
+
+	recorder, ok := translator.(push.KeyRecorder)
+	if !ok {
+		return fmt.Errorf("generated keys are not supported by this translator")
+	}
+
+	for key := range generatedKeys {
+		generatedKey, err := parseKey(key)
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Str("table", generatedKey.TableName).Str("column", generatedKey.ColumnName).Msg("enabling generated key")
+
+		recorder.Generate(generatedKey)
+	}
+
+	return nil
+}
+
+// exportGeneratedKeys writes the generated values in translation files, usable with the --pk-translation flag
+func exportGeneratedKeys(generatedKeys map[string]string) error {
+	if len(generatedKeys) == 0 {
+		return nil
+	}
+
+	recorder, ok := translator.(push.KeyRecorder)
+	if !ok {
+		return fmt.Errorf("generated keys are not supported by this translator")
+	}
+
+	mappings := recorder.GeneratedKeys()
+	writers := map[string]push.RowWriter{}
+
+	for key, filename := range generatedKeys {
+		generatedKey, err := parseKey(key)
+		if err != nil {
+			return err
+		}
+
+		writer, exists := writers[filename]
+		if !exists {
+			file, err := os.Create(filename) //nolint:gosec
+			if err != nil {
+				return err
+			}
+
+			defer file.Close() //nolint:errcheck
+
+			writer = rowExporterFactory(file)
+			writers[filename] = writer
+		}
+
+		log.Debug().Str("table", generatedKey.TableName).Str("column", generatedKey.ColumnName).Str("file", filename).Msg("exporting generated keys")
+
+		for value, generated := range mappings[generatedKey] {
+			if err := writer.Write(push.Row{"key": generated, "value": value}, nil); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func enableBulk(datadestination push.DataDestination, mode push.Mode) *push.Error {
+	if mode != push.Insert && mode != push.Truncate {
+		return &push.Error{Description: fmt.Sprintf("bulk load is not available with mode %s", mode)}
+	}
+
+	bulkDestination, ok := datadestination.(push.BulkDataDestination)
+	if !ok {
+		return &push.Error{Description: "bulk load is not supported by this datadestination"}
+	}
+
+	bulkDestination.SetBulk(true)
+
+	return nil
+}
+
+func setConflictPolicy(datadestination push.DataDestination, mode push.Mode, onConflict string, bulk bool) *push.Error {
+	policy, err := push.ParseConflictPolicy(onConflict)
+	if err != nil {
+		return err
+	}
+
+	if mode != push.Insert && mode != push.Truncate && mode != push.Upsert {
+		return &push.Error{Description: fmt.Sprintf("conflict policy is not available with mode %s", mode)}
+	}
+
+	if bulk && policy.Resolve(mode) != push.ConflictSkip {
+		return &push.Error{Description: fmt.Sprintf("conflict policy %s can not be used with flag --bulk", onConflict)}
+	}
+
+	conflictDestination, ok := datadestination.(push.ConflictDataDestination)
+	if !ok {
+		return &push.Error{Description: "conflict policies are not supported by this datadestination"}
+	}
+
+	conflictDestination.SetConflictPolicy(policy)
+
+	return nil
+}
+
 func getDataDestination(dataconnectorName string) (push.DataDestination, *push.Error) {
 	alias, e1 := dataconnector.Get(dataconnectorStorage, dataconnectorName)
 	if e1 != nil {
@@ -276,6 +625,87 @@ func getDataDestination(dataconnectorName string) (push.DataDestination, *push.E
 	return datadestinationFactory.New(u.URL.String(), alias.Schema), nil
 }
 
+func getScriptDataDestination(dataconnectorName string, script io.Writer) (push.DataDestination, *push.Error) {
+	alias, e1 := dataconnector.Get(dataconnectorStorage, dataconnectorName)
+	if e1 != nil {
+		return nil, &push.Error{Description: e1.Error()}
+	}
+	if alias == nil {
+		return nil, &push.Error{Description: fmt.Sprintf("'%s' dataconnector not found", dataconnectorName)}
+	}
+
+	u := urlbuilder.BuildURL(alias, nil)
+
+	datadestinationFactory, ok := datadestinationFactories[u.UnaliasedDriver].(push.ScriptDataDestinationFactory)
+	if !ok {
+		return nil, &push.Error{Description: "SQL script is not supported for database type " + u.UnaliasedDriver}
+	}
+
+	return datadestinationFactory.NewScript(script, alias.Schema)
+}
+
+func getDiffDataDestination(dataconnectorName string, report push.RowWriter) (push.DataDestination, *push.Error) {
+	alias, e1 := dataconnector.Get(dataconnectorStorage, dataconnectorName)
+	if e1 != nil {
+		return nil, &push.Error{Description: e1.Error()}
+	}
+	if alias == nil {
+		return nil, &push.Error{Description: fmt.Sprintf("'%s' dataconnector not found", dataconnectorName)}
+	}
+
+	u := urlbuilder.BuildURL(alias, nil)
+
+	datadestinationFactory, ok := datadestinationFactories[u.UnaliasedDriver].(push.DiffDataDestinationFactory)
+	if !ok {
+		return nil, &push.Error{Description: "push plan is not supported for database type " + u.UnaliasedDriver}
+	}
+
+	return datadestinationFactory.NewDiff(u.URL.String(), alias.Schema, report), nil
+}
+
+// checkReadOnlyFlags checks that flags writing in the database are not used with flag
+func checkReadOnlyFlags(flag string, parallel uint, bulk bool, logSQLTo string, generatedKeys map[string]string) *push.Error {
+	switch {
+	case parallel > 1:
+		return &push.Error{Description: fmt.Sprintf("flag %s can not be used with parallel workers", flag)}
+	case bulk:
+		return &push.Error{Description: fmt.Sprintf("flag %s can not be used with flag --bulk", flag)}
+	case logSQLTo != "":
+		return &push.Error{Description: fmt.Sprintf("flag %s can not be used with flag --log-sql", flag)}
+	case len(generatedKeys) > 0:
+		return &push.Error{Description: fmt.Sprintf("flag %s can not be used with flag --generated-key", flag)}
+	}
+	return nil
+}
+
+func checkFanOutFlags(parallel uint, toScript string, diff bool, journal string, savepoint string, logSQLTo string, generatedKeys map[string]string) *push.Error {
+	const message = "flag %s can not be used when pushing to several dataconnectors"
+	switch {
+	case parallel > 1:
+		return &push.Error{Description: fmt.Sprintf(message, "--parallel")}
+	case toScript != "":
+		return &push.Error{Description: fmt.Sprintf(message, "--to-script")}
+	case diff:
+		return &push.Error{Description: fmt.Sprintf(message, "--plan")}
+	case journal != "":
+		return &push.Error{Description: fmt.Sprintf(message, "--journal")}
+	case savepoint != "":
+		return &push.Error{Description: fmt.Sprintf(message, "--savepoint")}
+	case logSQLTo != "":
+		return &push.Error{Description: fmt.Sprintf(message, "--log-sql")}
+	case len(generatedKeys) > 0:
+		return &push.Error{Description: fmt.Sprintf(message, "--generated-key")}
+	}
+	return nil
+}
+
+// catchErrorsFilename returns the file catching errors of one dataconnector when pushing to several dataconnectors,
+// the name of the dataconnector is inserted before the extension (errors.jsonl becomes errors.dest1.jsonl)
+func catchErrorsFilename(catchErrors string, dataconnector string) string {
+	extension := filepath.Ext(catchErrors)
+	return strings.TrimSuffix(catchErrors, extension) + "." + dataconnector + extension
+}
+
 func getPlan(idStorage id.Storage, autoTruncate bool) (push.Plan, *push.Error) {
 	id, err1 := idStorage.Read()
 	if err1 != nil {
@@ -335,10 +765,16 @@ func (c idToPushConverter) getTable(name string, autoTruncate bool) push.Table {
 
 	columns := []push.Column{}
 	for _, col := range table.Columns {
-		columns = append(columns, push.NewColumn(col.Name, col.Export, col.Import, col.DBInfo.Length, col.DBInfo.ByteBased, autoTruncate, col.Preserve))
+		imp := col.Import
+		if col.DBInfo.Generated != "" && imp == "" {
+			// the database computes the values of generated columns, they can't be written
+			log.Debug().Str("table", table.Name).Str("column", col.Name).Msg("skipping generated column")
+			imp = "no"
+		}
+		columns = append(columns, push.NewColumn(col.Name, col.Export, imp, col.DBInfo.Length, col.DBInfo.ByteBased, autoTruncate, col.Preserve))
 	}
 
-	return push.NewTable(table.Name, table.Keys, push.NewColumnList(columns))
+	return push.NewTableWithVersion(table.Name, table.Keys, push.NewColumnList(columns), table.Version)
 }
 
 func (c idToPushConverter) getRelation(name string, autoTruncate bool) push.Relation {
@@ -354,10 +790,12 @@ func (c idToPushConverter) getRelation(name string, autoTruncate bool) push.Rela
 
 	log.Trace().Msg(fmt.Sprintf("building relation %v", relation))
 
-	return push.NewRelation(
+	return push.NewRelationWithKeys(
 		relation.Name,
 		c.getTable(relation.Parent.Name, autoTruncate),
 		c.getTable(relation.Child.Name, autoTruncate),
+		relation.Parent.Keys,
+		relation.Child.Keys,
 	)
 }
 
@@ -74,3 +74,78 @@ type args struct {
 		})
 	}
 }
+
+func Test_parseArguments(t *testing.T) {
+	tests := []struct {
+		name  string
+		args  []string
+		want  []destinationArgument
+		want1 *push.Error
+	}{
+		{
+			name: "dataconnector only",
+			args: []string{"dest"},
+			want: []destinationArgument{{dataconnector: "dest", mode: push.Insert}},
+		},
+		{
+			name: "mode and dataconnector",
+			args: []string{"truncate", "dest"},
+			want: []destinationArgument{{dataconnector: "dest", mode: push.Truncate}},
+		},
+		{
+			name: "several dataconnectors with their own mode",
+			args: []string{"truncate", "dest1", "dest2:upsert"},
+			want: []destinationArgument{{dataconnector: "dest1", mode: push.Truncate}, {dataconnector: "dest2", mode: push.Upsert}},
+		},
+		{
+			name: "several dataconnectors without default mode",
+			args: []string{"dest1", "dest2"},
+			want: []destinationArgument{{dataconnector: "dest1", mode: push.Insert}, {dataconnector: "dest2", mode: push.Insert}},
+		},
+		{
+			name:  "duplicate dataconnector",
+			args:  []string{"dest1", "dest1:update"},
+			want1: &push.Error{Description: "dataconnector dest1 is given more than once"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1 := parseArguments(tt.args)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseArguments() got = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(got1, tt.want1) {
+				t.Errorf("parseArguments() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
+func Test_getTableSkipsGeneratedColumns(t *testing.T) {
+	converter := idToPushConverter{
+		tmap: map[string]table.Table{
+			"customer": {
+				Name: "customer",
+				Keys: []string{"id"},
+				Columns: []table.Column{
+					{Name: "id"},
+					{Name: "domain", DBInfo: table.DBInfo{Generated: "split_part(email, '@', 2)"}},
+					{Name: "total", Import: "numeric", DBInfo: table.DBInfo{Generated: "price * quantity"}},
+				},
+			},
+		},
+		pushtmap: map[string]push.Table{},
+	}
+
+	columns := converter.getTable("customer", false).Columns()
+
+	if got := columns.Column(0).Import(); got != "" {
+		t.Errorf("getTable() import of id = %v, want empty", got)
+	}
+	if got := columns.Column(1).Import(); got != "no" {
+		t.Errorf("getTable() import of domain = %v, want no", got)
+	}
+	if got := columns.Column(2).Import(); got != "numeric" {
+		t.Errorf("getTable() import of total = %v, want numeric", got)
+	}
+}
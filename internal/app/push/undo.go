@@ -0,0 +1,75 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cgi-fr/lino/pkg/push"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+// newUndoCommand implements the cli push undo command
+func newUndoCommand(fullName string, err *os.File, out *os.File, in *os.File) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "undo <journal> [Data Connector Name]",
+		Short:   "Revert a push with the journal recorded by the --journal flag",
+		Long:    "",
+		Example: fmt.Sprintf("  %[1]s push delete dstdatabase --journal journal.jsonl\n  %[1]s push undo journal.jsonl dstdatabase", fullName),
+		Args:    cobra.ExactArgs(2),
+		PreRun: func(cmd *cobra.Command, args []string) {
+			log.Info().
+				Str("journal", args[0]).
+				Str("dataconnector", args[1]).
+				Msg("Push undo")
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			datadestination, e1 := getDataDestination(args[1])
+			if e1 != nil {
+				fmt.Fprintln(err, e1.Error()) //nolint:errcheck
+				os.Exit(1)
+			}
+
+			journalDestination, ok := datadestination.(push.JournalDataDestination)
+			if !ok {
+				fmt.Fprintln(err, "journal is not supported by this datadestination") //nolint:errcheck
+				os.Exit(1)
+			}
+
+			journalFile, e2 := os.Open(args[0]) //nolint:gosec
+			if e2 != nil {
+				fmt.Fprintln(err, e2.Error()) //nolint:errcheck
+				os.Exit(1)
+			}
+
+			journal := rowIteratorFactory(journalFile)
+			defer journal.Close() //nolint:errcheck
+
+			if e3 := journalDestination.Undo(journal); e3 != nil {
+				fmt.Fprintln(err, e3.Error()) //nolint:errcheck
+				os.Exit(1)
+			}
+		},
+	}
+	cmd.SetOut(out)
+	cmd.SetErr(err)
+	cmd.SetIn(in)
+	return cmd
+}
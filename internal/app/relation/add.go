@@ -0,0 +1,78 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package relation
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cgi-fr/lino/pkg/relation"
+	"github.com/spf13/cobra"
+)
+
+// newAddCommand implements the cli relation add command
+func newAddCommand(fullName string, err *os.File, out *os.File, in *os.File) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "add [Relation Name] [Parent Table].[Keys] [Child Table].[Keys]",
+		Short:   "Add a virtual relation in relations.yaml file",
+		Long:    "Add a relation that is not declared by a foreign key constraint, keys are separated by commas. Virtual relations are kept by the extract command.",
+		Example: fmt.Sprintf("  %[1]s relation add film_language public.language.language_id public.film.language_id", fullName),
+		Args:    cobra.ExactArgs(3),
+		Run: func(cmd *cobra.Command, args []string) {
+			parent, e1 := parseTable(args[1])
+			if e1 != nil {
+				fmt.Fprintln(err, e1.Description) //nolint:errcheck
+				os.Exit(1)
+			}
+
+			child, e2 := parseTable(args[2])
+			if e2 != nil {
+				fmt.Fprintln(err, e2.Description) //nolint:errcheck
+				os.Exit(1)
+			}
+
+			if len(parent.Keys) != len(child.Keys) {
+				fmt.Fprintf(err, "parent and child must have the same number of keys (%d != %d)\n", len(parent.Keys), len(child.Keys)) //nolint:errcheck
+				os.Exit(1)
+			}
+
+			e3 := relation.Add(relationStorage, relation.Relation{Name: args[0], Parent: parent, Child: child})
+			if e3 != nil {
+				fmt.Fprintln(err, e3.Description) //nolint:errcheck
+				os.Exit(1)
+			}
+
+			fmt.Fprintf(out, "successfully added relation %v\n", args[0]) //nolint:errcheck
+		},
+	}
+	cmd.SetOut(out)
+	cmd.SetErr(err)
+	cmd.SetIn(in)
+	return cmd
+}
+
+// parseTable reads a table name followed by its keys separated by commas (e.g. public.film.language_id,film_id)
+func parseTable(arg string) (relation.Table, *relation.Error) {
+	i := strings.LastIndex(arg, ".")
+	if i <= 0 || i == len(arg)-1 {
+		return relation.Table{}, &relation.Error{Description: fmt.Sprintf("invalid table %s, expected table.keys", arg)}
+	}
+
+	return relation.Table{Name: arg[:i], Keys: strings.Split(arg[i+1:], ",")}, nil
+}
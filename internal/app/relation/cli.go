@@ -23,30 +23,41 @@
 
 	"github.com/cgi-fr/lino/pkg/dataconnector"
 	"github.com/cgi-fr/lino/pkg/relation"
+	"github.com/cgi-fr/lino/pkg/table"
 	"github.com/spf13/cobra"
 )
 
 var dataconnectorStorage dataconnector.Storage
 var relationStorage relation.Storage
 var relationExtractorFactories map[string]relation.ExtractorFactory
+var tableExtractorFactories map[string]table.ExtractorFactory
+var inclusionCheckerFactories map[string]relation.InclusionCheckerFactory
 
 // Inject dependencies
-func Inject(dbas dataconnector.Storage, rs relation.Storage, exmap map[string]relation.ExtractorFactory) {
+func Inject(dbas dataconnector.Storage, rs relation.Storage, exmap map[string]relation.ExtractorFactory,
+	texmap map[string]table.ExtractorFactory, icmap map[string]relation.InclusionCheckerFactory,
+) {
 	dataconnectorStorage = dbas
 	relationStorage = rs
 	relationExtractorFactories = exmap
+	tableExtractorFactories = texmap
+	inclusionCheckerFactories = icmap
 }
 
 // NewCommand implements the cli dataconnector command
 func NewCommand(fullName string, err *os.File, out *os.File, in *os.File) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "relation {extract} [arguments ...]",
+		Use:     "relation {extract,infer,add,remove,list} [arguments ...]",
 		Short:   "Manage relations",
 		Long:    "",
 		Example: fmt.Sprintf("  %[1]s relation extract mydatabase", fullName),
 		Aliases: []string{"rel"},
 	}
 	cmd.AddCommand(newExtractCommand(fullName, err, out, in))
+	cmd.AddCommand(newInferCommand(fullName, err, out, in))
+	cmd.AddCommand(newAddCommand(fullName, err, out, in))
+	cmd.AddCommand(newRemoveCommand(fullName, err, out, in))
+	cmd.AddCommand(newListCommand(fullName, err, out, in))
 	cmd.SetOut(out)
 	cmd.SetErr(err)
 	cmd.SetIn(in)
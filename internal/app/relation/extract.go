@@ -21,6 +21,7 @@
 	"fmt"
 	"os"
 
+	"github.com/cgi-fr/lino/internal/app/namefilter"
 	"github.com/cgi-fr/lino/internal/app/urlbuilder"
 	"github.com/cgi-fr/lino/pkg/dataconnector"
 	"github.com/cgi-fr/lino/pkg/relation"
@@ -29,6 +30,11 @@
 	"github.com/spf13/cobra"
 )
 
+var (
+	include []string
+	exclude []string
+)
+
 // newExtractCommand implements the cli relation extract command
 func newExtractCommand(fullName string, err *os.File, out *os.File, in *os.File) *cobra.Command {
 	cmd := &cobra.Command{
@@ -63,8 +69,22 @@ func newExtractCommand(fullName string, err *os.File, out *os.File, in *os.File)
 			}
 
 			extractor := factory.New(u.URL.String(), alias.Schema)
+			if len(alias.Schemas) > 0 {
+				multiSchemaFactory, ok := factory.(relation.MultiSchemaExtractorFactory)
+				if !ok {
+					fmt.Fprintln(err, "extraction across several schemas is not supported for database type") //nolint:errcheck
+					os.Exit(1)
+				}
+				extractor = multiSchemaFactory.NewMultiSchema(u.URL.String(), append([]string{alias.Schema}, alias.Schemas...))
+			}
 
-			e2 := relation.Extract(extractor, relationStorage)
+			filter, e3 := namefilter.New(include, exclude)
+			if e3 != nil {
+				fmt.Fprintln(err, e3.Error()) //nolint:errcheck
+				os.Exit(1)
+			}
+
+			e2 := relation.Extract(extractor, relationStorage, filter)
 			if e2 != nil {
 				fmt.Fprintln(err, e2.Description) //nolint:errcheck
 				os.Exit(1)
@@ -76,9 +96,21 @@ func newExtractCommand(fullName string, err *os.File, out *os.File, in *os.File)
 				os.Exit(1)
 			}
 
-			fmt.Fprintf(out, "lino finds %v relations from constraints\n", len(relations)) //nolint:errcheck
+			virtuals := 0
+			for _, r := range relations {
+				if r.Virtual {
+					virtuals++
+				}
+			}
+
+			fmt.Fprintf(out, "lino finds %v relations from constraints\n", len(relations)-virtuals) //nolint:errcheck
+			if virtuals > 0 {
+				fmt.Fprintf(out, "lino keeps %v virtual relations\n", virtuals) //nolint:errcheck
+			}
 		},
 	}
+	cmd.Flags().StringSliceVar(&include, "include", []string{}, "extract only the relations between tables matching one of these patterns (glob, or regular expression between slashes)")
+	cmd.Flags().StringSliceVar(&exclude, "exclude", []string{}, "don't extract the relations with a table matching one of these patterns (glob, or regular expression between slashes)")
 	cmd.SetOut(out)
 	cmd.SetErr(err)
 	cmd.SetIn(in)
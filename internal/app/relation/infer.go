@@ -0,0 +1,142 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package relation
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cgi-fr/lino/internal/app/urlbuilder"
+	"github.com/cgi-fr/lino/pkg/dataconnector"
+	"github.com/cgi-fr/lino/pkg/relation"
+	"github.com/cgi-fr/lino/pkg/table"
+	"github.com/rs/zerolog/log"
+
+	"github.com/spf13/cobra"
+)
+
+// newInferCommand implements the cli relation infer command
+func newInferCommand(fullName string, err *os.File, out *os.File, in *os.File) *cobra.Command {
+	var sampleSize uint
+	var minConfidence float64
+	var accept bool
+
+	cmd := &cobra.Command{
+		Use:   "infer [Data Connector Name]",
+		Short: "Infer candidate relations from column names and data inclusion",
+		Long: "Propose relations for databases without foreign key constraints. A column whose name refers to the primary key of another table is a candidate, " +
+			"its confidence is raised if the sampled values of the column all exist in the primary key. Candidates are added to relations.yaml as virtual relations with --accept.",
+		Example: fmt.Sprintf("  %[1]s relation infer mydatabase\n  %[1]s relation infer mydatabase --min-confidence 0.9 --accept", fullName),
+		Args:    cobra.ExactArgs(1),
+		PreRun: func(cmd *cobra.Command, args []string) {
+			log.Info().
+				Str("dataconnector", args[0]).
+				Uint("sampleSize", sampleSize).
+				Float64("minConfidence", minConfidence).
+				Bool("accept", accept).
+				Msg("Infer relation")
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			alias, e1 := dataconnector.Get(dataconnectorStorage, args[0])
+			if e1 != nil {
+				fmt.Fprintln(err, e1.Description) //nolint:errcheck
+				os.Exit(1)
+			}
+
+			if alias == nil {
+				fmt.Fprintln(err, "no dataconnector named "+args[0]) //nolint:errcheck
+				os.Exit(1)
+			}
+
+			u := urlbuilder.BuildURL(alias, err)
+
+			tableFactory, ok := tableExtractorFactories[u.UnaliasedDriver]
+			if !ok {
+				fmt.Fprintln(err, "no extractor found for database type") //nolint:errcheck
+				os.Exit(1)
+			}
+
+			checkerFactory, ok := inclusionCheckerFactories[u.UnaliasedDriver]
+			if !ok {
+				fmt.Fprintln(err, "no inclusion checker found for database type") //nolint:errcheck
+				os.Exit(1)
+			}
+
+			tables, e2 := tableFactory.New(u.URL.String(), alias.Schema).Extract(false, true)
+			if e2 != nil {
+				fmt.Fprintln(err, e2.Description) //nolint:errcheck
+				os.Exit(1)
+			}
+
+			existing, e3 := relationStorage.List()
+			if e3 != nil {
+				log.Debug().Str("error", e3.Description).Msg("no stored relations")
+				existing = nil
+			}
+
+			checker := checkerFactory.New(u.URL.String(), alias.Schema)
+			defer checker.Close() //nolint:errcheck
+
+			candidates, e4 := relation.Infer(tableDefinitions(tables), checker, existing, relation.InferConfig{
+				SampleSize:    sampleSize,
+				MinConfidence: minConfidence,
+			})
+			if e4 != nil {
+				fmt.Fprintln(err, e4.Description) //nolint:errcheck
+				os.Exit(1)
+			}
+
+			for _, c := range candidates {
+				fmt.Fprintf(out, "%.2f %s %s.%s %s.%s (%d/%d sampled values found)\n", c.Confidence, c.Relation.Name, //nolint:errcheck
+					c.Relation.Parent.Name, c.Relation.Parent.Keys[0], c.Relation.Child.Name, c.Relation.Child.Keys[0], c.Found, c.Sampled)
+			}
+
+			if accept {
+				accepted, e5 := relation.Accept(relationStorage, candidates)
+				if e5 != nil {
+					fmt.Fprintln(err, e5.Description) //nolint:errcheck
+					os.Exit(1)
+				}
+
+				fmt.Fprintf(out, "lino adds %v virtual relations\n", accepted) //nolint:errcheck
+			}
+		},
+	}
+	cmd.Flags().UintVar(&sampleSize, "sample-size", 100, "number of distinct values of the child column checked in the parent key, 0 disables the check")
+	cmd.Flags().Float64Var(&minConfidence, "min-confidence", 0.5, "minimum confidence of the proposed relations, between 0 and 1")
+	cmd.Flags().BoolVar(&accept, "accept", false, "add the proposed relations to relations.yaml as virtual relations")
+	cmd.SetOut(out)
+	cmd.SetErr(err)
+	cmd.SetIn(in)
+	return cmd
+}
+
+func tableDefinitions(tables []table.Table) []relation.TableDefinition {
+	result := make([]relation.TableDefinition, 0, len(tables))
+
+	for _, t := range tables {
+		columns := make([]relation.Column, 0, len(t.Columns))
+		for _, c := range t.Columns {
+			columns = append(columns, relation.Column{Name: c.Name, Type: c.DBInfo.Type})
+		}
+
+		result = append(result, relation.TableDefinition{Name: t.Name, Keys: t.Keys, Columns: columns})
+	}
+
+	return result
+}
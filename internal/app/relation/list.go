@@ -0,0 +1,58 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package relation
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cgi-fr/lino/pkg/relation"
+	"github.com/spf13/cobra"
+)
+
+// newListCommand implements the cli relation list command
+func newListCommand(fullName string, err *os.File, out *os.File, in *os.File) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List relations of relations.yaml file",
+		Long:    "",
+		Example: fmt.Sprintf("  %[1]s relation list", fullName),
+		Args:    cobra.NoArgs,
+		Aliases: []string{"ls"},
+		Run: func(cmd *cobra.Command, args []string) {
+			list, e := relation.List(relationStorage)
+			if e != nil {
+				fmt.Fprintln(err, e.Description) //nolint:errcheck
+				os.Exit(1)
+			}
+
+			for _, r := range list {
+				line := fmt.Sprintf("%s %s.%s %s.%s", r.Name, r.Parent.Name, strings.Join(r.Parent.Keys, ","), r.Child.Name, strings.Join(r.Child.Keys, ","))
+				if r.Virtual {
+					line += " (virtual)"
+				}
+				fmt.Fprintln(out, line) //nolint:errcheck
+			}
+		},
+	}
+	cmd.SetOut(out)
+	cmd.SetErr(err)
+	cmd.SetIn(in)
+	return cmd
+}
@@ -0,0 +1,51 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package relation
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cgi-fr/lino/pkg/relation"
+	"github.com/spf13/cobra"
+)
+
+// newRemoveCommand implements the cli relation remove command
+func newRemoveCommand(fullName string, err *os.File, out *os.File, in *os.File) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove [Relation Name]",
+		Short:   "Remove a relation from relations.yaml file",
+		Long:    "",
+		Example: fmt.Sprintf("  %[1]s relation remove film_language", fullName),
+		Args:    cobra.ExactArgs(1),
+		Aliases: []string{"rm"},
+		Run: func(cmd *cobra.Command, args []string) {
+			e1 := relation.Remove(relationStorage, args[0])
+			if e1 != nil {
+				fmt.Fprintln(err, e1.Description) //nolint:errcheck
+				os.Exit(1)
+			}
+
+			fmt.Fprintf(out, "successfully removed relation %v\n", args[0]) //nolint:errcheck
+		},
+	}
+	cmd.SetOut(out)
+	cmd.SetErr(err)
+	cmd.SetIn(in)
+	return cmd
+}
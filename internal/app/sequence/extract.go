@@ -21,6 +21,7 @@
 	"fmt"
 	"os"
 
+	"github.com/cgi-fr/lino/internal/app/namefilter"
 	"github.com/cgi-fr/lino/internal/app/urlbuilder"
 	"github.com/cgi-fr/lino/pkg/dataconnector"
 	"github.com/cgi-fr/lino/pkg/sequence"
@@ -28,6 +29,11 @@
 	"github.com/spf13/cobra"
 )
 
+var (
+	include []string
+	exclude []string
+)
+
 // newExtractCommand implements the cli relation extract command
 func newExtractCommand(fullName string, err *os.File, out *os.File, in *os.File) *cobra.Command {
 	cmd := &cobra.Command{
@@ -74,13 +80,21 @@ func newExtractCommand(fullName string, err *os.File, out *os.File, in *os.File)
 				tables = append(tables, sequence.Table{Name: tbl.Name, Keys: tbl.Keys})
 			}
 
-			e3 := sequence.Extract(extractor, tables, sequenceStorage)
+			filter, e4 := namefilter.New(include, exclude)
+			if e4 != nil {
+				fmt.Fprintln(err, e4.Error()) //nolint:errcheck
+				os.Exit(1)
+			}
+
+			e3 := sequence.Extract(extractor, tables, sequenceStorage, filter)
 			if e3 != nil {
-				fmt.Fprintln(err, e2.Description) //nolint:errcheck
+				fmt.Fprintln(err, e3.Description) //nolint:errcheck
 				os.Exit(1)
 			}
 		},
 	}
+	cmd.Flags().StringSliceVar(&include, "include", []string{}, "extract only the sequences of tables matching one of these patterns (glob, or regular expression between slashes)")
+	cmd.Flags().StringSliceVar(&exclude, "exclude", []string{}, "don't extract the sequences of tables matching one of these patterns (glob, or regular expression between slashes)")
 	cmd.SetOut(out)
 	cmd.SetErr(err)
 	cmd.SetIn(in)
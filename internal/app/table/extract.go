@@ -21,6 +21,7 @@
 	"fmt"
 	"os"
 
+	"github.com/cgi-fr/lino/internal/app/namefilter"
 	"github.com/cgi-fr/lino/internal/app/urlbuilder"
 	"github.com/cgi-fr/lino/pkg/dataconnector"
 	"github.com/cgi-fr/lino/pkg/table"
@@ -31,6 +32,9 @@
 var (
 	onlyTables  bool
 	withDBInfos bool
+	include     []string
+	exclude     []string
+	types       []string
 )
 
 // newExtractCommand implements the cli relation extract command
@@ -66,9 +70,31 @@ func newExtractCommand(fullName string, err *os.File, out *os.File, in *os.File)
 				os.Exit(1)
 			}
 
-			extractor := factory.New(u.URL.String(), alias.Schema)
+			objectTypes, e3 := parseTypes(types)
+			if e3 != nil {
+				fmt.Fprintln(err, e3.Description) //nolint:errcheck
+				os.Exit(1)
+			}
+
+			filter, e4 := namefilter.New(include, exclude)
+			if e4 != nil {
+				fmt.Fprintln(err, e4.Error()) //nolint:errcheck
+				os.Exit(1)
+			}
 
-			e2 := table.Extract(extractor, tableStorage, onlyTables, withDBInfos)
+			var extractor table.Extractor
+			if len(alias.Schemas) > 0 || len(objectTypes) != 1 || objectTypes[0] != table.ObjectTypeTable {
+				scoped, ok := factory.(table.ScopedExtractorFactory)
+				if !ok {
+					fmt.Fprintln(err, "other schemas and object types are not supported for this database type") //nolint:errcheck
+					os.Exit(1)
+				}
+				extractor = scoped.NewScoped(u.URL.String(), append([]string{alias.Schema}, alias.Schemas...), objectTypes)
+			} else {
+				extractor = factory.New(u.URL.String(), alias.Schema)
+			}
+
+			e2 := table.Extract(extractor, tableStorage, onlyTables, withDBInfos, filter)
 			if e2 != nil {
 				fmt.Fprintln(err, e2.Description) //nolint:errcheck
 				os.Exit(1)
@@ -84,9 +110,26 @@ func newExtractCommand(fullName string, err *os.File, out *os.File, in *os.File)
 		},
 	}
 	cmd.Flags().BoolVar(&onlyTables, "only-tables", false, "extract tables without columns informations")
-	cmd.Flags().BoolVar(&withDBInfos, "with-db-infos", false, "extract tables with columns informations including types, length/size, precision")
+	cmd.Flags().BoolVar(&withDBInfos, "with-db-infos", false, "extract tables with columns informations including types, length/size, precision, constraints, indexes and comments")
+	cmd.Flags().StringSliceVar(&include, "include", []string{}, "extract only the tables matching one of these patterns (glob, or regular expression between slashes)")
+	cmd.Flags().StringSliceVar(&exclude, "exclude", []string{}, "don't extract the tables matching one of these patterns (glob, or regular expression between slashes)")
+	cmd.Flags().StringSliceVar(&types, "types", []string{string(table.ObjectTypeTable)}, "types of objects to extract (table, view, materialized-view, synonym)")
 	cmd.SetOut(out)
 	cmd.SetErr(err)
 	cmd.SetIn(in)
 	return cmd
 }
+
+// parseTypes converts the values of the flag --types
+func parseTypes(values []string) ([]table.ObjectType, *table.Error) {
+	result := []table.ObjectType{}
+	for _, value := range values {
+		switch objectType := table.ObjectType(value); objectType {
+		case table.ObjectTypeTable, table.ObjectTypeView, table.ObjectTypeMaterializedView, table.ObjectTypeSynonym:
+			result = append(result, objectType)
+		default:
+			return nil, &table.Error{Description: fmt.Sprintf("unknown object type %s, expected table, view, materialized-view or synonym", value)}
+		}
+	}
+	return result, nil
+}
@@ -134,7 +134,7 @@ func (ds *SQLDataSource) Open() error {
 	// Get WHERE Clause query
 	sqlWhere, values := commonsql.GetWhereSQLAndValues(map[string]any{}, ds.where, ds.dialect)
 
-	sql := ds.dialect.Select(ds.table, ds.schema, sqlWhere, false, commonsql.ColumnExportDefinition{Name: ds.column})
+	sql := ds.dialect.Select(ds.table, ds.schema, sqlWhere, false, nil, commonsql.ColumnExportDefinition{Name: ds.column})
 
 	// If log level is more than debug level, this function will log all SQL Query
 	commonsql.LogSQLQuery(sql, values, ds.dialect)
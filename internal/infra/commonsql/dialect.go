@@ -19,6 +19,7 @@
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/rs/zerolog"
@@ -47,10 +48,12 @@ type Dialect interface {
 	From(tableName string, schemaName string) string
 	// Where clause
 	Where(string) string
+	// OrderBy clause
+	OrderBy(orderBy []string) string
 	// Select clause
-	Select(tableName string, schemaName string, where string, distinct bool, columns ...ColumnExportDefinition) string
+	Select(tableName string, schemaName string, where string, distinct bool, orderBy []string, columns ...ColumnExportDefinition) string
 	// SelectLimit clause
-	SelectLimit(tableName string, schemaName string, where string, distinct bool, limit uint, columns ...ColumnExportDefinition) string
+	SelectLimit(tableName string, schemaName string, where string, distinct bool, orderBy []string, limit uint, columns ...ColumnExportDefinition) string
 	// Quote identifier
 	Quote(id string) string
 
@@ -77,9 +80,17 @@ func GetWhereSQLAndValues(filters map[string]any, where string, d Dialect) (stri
 
 	if len(filters) > 0 || len(where) > 0 {
 		whereContentFlag := false
-		for key, value := range filters {
+
+		// keys are sorted to produce the same query for the same filter
+		keys := make([]string, 0, len(filters))
+		for key := range filters {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
 			sqlWhere.WriteString(key)
-			values = append(values, value)
+			values = append(values, filters[key])
 			sqlWhere.WriteString("=")
 			sqlWhere.WriteString(d.Placeholder(len(values)))
 			if len(values) < len(filters) {
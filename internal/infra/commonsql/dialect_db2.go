@@ -52,8 +52,17 @@ func (db2 Db2Dialect) Where(where string) string {
 	return fmt.Sprintf("WHERE %s", where)
 }
 
+// OrderBy clause
+func (db2 Db2Dialect) OrderBy(orderBy []string) string {
+	if len(orderBy) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("ORDER BY %s", strings.Join(orderBy, ", "))
+}
+
 // Select clause
-func (db2 Db2Dialect) Select(tableName string, schemaName string, where string, distinct bool, columns ...ColumnExportDefinition) string {
+func (db2 Db2Dialect) Select(tableName string, schemaName string, where string, distinct bool, orderBy []string, columns ...ColumnExportDefinition) string {
 	var query strings.Builder
 
 	query.WriteString("SELECT ")
@@ -80,11 +89,16 @@ func (db2 Db2Dialect) Select(tableName string, schemaName string, where string,
 	query.WriteRune(' ')
 	query.WriteString(db2.Where(where))
 
+	if len(orderBy) > 0 {
+		query.WriteRune(' ')
+		query.WriteString(db2.OrderBy(orderBy))
+	}
+
 	return query.String()
 }
 
 // SelectLimit clause
-func (db2 Db2Dialect) SelectLimit(tableName string, schemaName string, where string, distinct bool, limit uint, columns ...ColumnExportDefinition) string {
+func (db2 Db2Dialect) SelectLimit(tableName string, schemaName string, where string, distinct bool, orderBy []string, limit uint, columns ...ColumnExportDefinition) string {
 	var query strings.Builder
 
 	query.WriteString("SELECT ")
@@ -110,6 +124,12 @@ func (db2 Db2Dialect) SelectLimit(tableName string, schemaName string, where str
 	query.WriteString(db2.From(tableName, schemaName))
 	query.WriteRune(' ')
 	query.WriteString(db2.Where(where))
+
+	if len(orderBy) > 0 {
+		query.WriteRune(' ')
+		query.WriteString(db2.OrderBy(orderBy))
+	}
+
 	query.WriteRune(' ')
 	query.WriteString(db2.Limit(limit))
 
@@ -158,5 +178,9 @@ func (db2 Db2Dialect) DisableConstraintsStatement(tableName string) string {
 
 // TruncateStatement generate statement to truncat table content
 func (db2 Db2Dialect) TruncateStatement(tableName string) string {
-	return fmt.Sprintf("TRUNCATE TABLE %s IMMEDIATE", db2.Quote(tableName))
+	schemaAndTable := strings.Split(tableName, ".")
+	if len(schemaAndTable) == 1 {
+		return fmt.Sprintf("TRUNCATE TABLE %s IMMEDIATE", db2.Quote(tableName))
+	}
+	return fmt.Sprintf("TRUNCATE TABLE %s IMMEDIATE", db2.Quote(schemaAndTable[0])+"."+db2.Quote(schemaAndTable[1]))
 }
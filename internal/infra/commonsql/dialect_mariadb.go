@@ -52,8 +52,17 @@ func (pd MariadbDialect) Where(where string) string {
 	return fmt.Sprintf("WHERE %s", where)
 }
 
+// OrderBy clause
+func (pd MariadbDialect) OrderBy(orderBy []string) string {
+	if len(orderBy) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("ORDER BY %s", strings.Join(orderBy, ", "))
+}
+
 // Select clause
-func (pd MariadbDialect) Select(tableName string, schemaName string, where string, distinct bool, columns ...ColumnExportDefinition) string {
+func (pd MariadbDialect) Select(tableName string, schemaName string, where string, distinct bool, orderBy []string, columns ...ColumnExportDefinition) string {
 	var query strings.Builder
 
 	query.WriteString("SELECT ")
@@ -80,11 +89,16 @@ func (pd MariadbDialect) Select(tableName string, schemaName string, where strin
 	query.WriteRune(' ')
 	query.WriteString(pd.Where(where))
 
+	if len(orderBy) > 0 {
+		query.WriteRune(' ')
+		query.WriteString(pd.OrderBy(orderBy))
+	}
+
 	return query.String()
 }
 
 // SelectLimit clause
-func (pd MariadbDialect) SelectLimit(tableName string, schemaName string, where string, distinct bool, limit uint, columns ...ColumnExportDefinition) string {
+func (pd MariadbDialect) SelectLimit(tableName string, schemaName string, where string, distinct bool, orderBy []string, limit uint, columns ...ColumnExportDefinition) string {
 	var query strings.Builder
 
 	query.WriteString("SELECT ")
@@ -110,6 +124,12 @@ func (pd MariadbDialect) SelectLimit(tableName string, schemaName string, where
 	query.WriteString(pd.From(tableName, schemaName))
 	query.WriteRune(' ')
 	query.WriteString(pd.Where(where))
+
+	if len(orderBy) > 0 {
+		query.WriteRune(' ')
+		query.WriteString(pd.OrderBy(orderBy))
+	}
+
 	query.WriteRune(' ')
 	query.WriteString(pd.Limit(limit))
 
@@ -158,5 +178,9 @@ func (d MariadbDialect) DisableConstraintsStatement(tableName string) string {
 
 // TruncateStatement generate statement to truncat table content
 func (d MariadbDialect) TruncateStatement(tableName string) string {
-	return fmt.Sprintf("TRUNCATE TABLE %s", d.Quote(tableName))
+	schemaAndTable := strings.Split(tableName, ".")
+	if len(schemaAndTable) == 1 {
+		return fmt.Sprintf("TRUNCATE TABLE %s", d.Quote(tableName))
+	}
+	return fmt.Sprintf("TRUNCATE TABLE %s", d.Quote(schemaAndTable[0])+"."+d.Quote(schemaAndTable[1]))
 }
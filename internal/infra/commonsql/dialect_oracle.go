@@ -52,8 +52,17 @@ func (od OracleDialect) Where(where string) string {
 	return fmt.Sprintf("WHERE %s", where)
 }
 
+// OrderBy clause
+func (od OracleDialect) OrderBy(orderBy []string) string {
+	if len(orderBy) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("ORDER BY %s", strings.Join(orderBy, ", "))
+}
+
 // Select clause
-func (od OracleDialect) Select(tableName string, schemaName string, where string, distinct bool, columns ...ColumnExportDefinition) string {
+func (od OracleDialect) Select(tableName string, schemaName string, where string, distinct bool, orderBy []string, columns ...ColumnExportDefinition) string {
 	var query strings.Builder
 
 	query.WriteString("SELECT ")
@@ -80,11 +89,16 @@ func (od OracleDialect) Select(tableName string, schemaName string, where string
 	query.WriteRune(' ')
 	query.WriteString(od.Where(where))
 
+	if len(orderBy) > 0 {
+		query.WriteRune(' ')
+		query.WriteString(od.OrderBy(orderBy))
+	}
+
 	return query.String()
 }
 
 // SelectLimit clause
-func (od OracleDialect) SelectLimit(tableName string, schemaName string, where string, distinct bool, limit uint, columns ...ColumnExportDefinition) string {
+func (od OracleDialect) SelectLimit(tableName string, schemaName string, where string, distinct bool, orderBy []string, limit uint, columns ...ColumnExportDefinition) string {
 	var query strings.Builder
 
 	query.WriteString("SELECT ")
@@ -110,6 +124,15 @@ func (od OracleDialect) SelectLimit(tableName string, schemaName string, where s
 	query.WriteString(od.From(tableName, schemaName))
 	query.WriteRune(' ')
 	query.WriteString(od.Where(where))
+
+	// rownum is evaluated before ORDER BY, the ordered query must be wrapped
+	if len(orderBy) > 0 {
+		query.WriteRune(' ')
+		query.WriteString(od.OrderBy(orderBy))
+
+		return fmt.Sprintf("SELECT * FROM (%s) WHERE rownum <= %d", query.String(), limit)
+	}
+
 	query.WriteRune(' ')
 	query.WriteString(od.Limit(limit))
 
@@ -52,8 +52,17 @@ func (pgd PostgresDialect) Where(where string) string {
 	return fmt.Sprintf("WHERE %s", where)
 }
 
+// OrderBy clause
+func (pgd PostgresDialect) OrderBy(orderBy []string) string {
+	if len(orderBy) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("ORDER BY %s", strings.Join(orderBy, ", "))
+}
+
 // Select clause
-func (pgd PostgresDialect) Select(tableName string, schemaName string, where string, distinct bool, columns ...ColumnExportDefinition) string {
+func (pgd PostgresDialect) Select(tableName string, schemaName string, where string, distinct bool, orderBy []string, columns ...ColumnExportDefinition) string {
 	var query strings.Builder
 
 	query.WriteString("SELECT ")
@@ -80,11 +89,16 @@ func (pgd PostgresDialect) Select(tableName string, schemaName string, where str
 	query.WriteRune(' ')
 	query.WriteString(pgd.Where(where))
 
+	if len(orderBy) > 0 {
+		query.WriteRune(' ')
+		query.WriteString(pgd.OrderBy(orderBy))
+	}
+
 	return query.String()
 }
 
 // SelectLimit clause
-func (pgd PostgresDialect) SelectLimit(tableName string, schemaName string, where string, distinct bool, limit uint, columns ...ColumnExportDefinition) string {
+func (pgd PostgresDialect) SelectLimit(tableName string, schemaName string, where string, distinct bool, orderBy []string, limit uint, columns ...ColumnExportDefinition) string {
 	var query strings.Builder
 
 	query.WriteString("SELECT ")
@@ -110,6 +124,12 @@ func (pgd PostgresDialect) SelectLimit(tableName string, schemaName string, wher
 	query.WriteString(pgd.From(tableName, schemaName))
 	query.WriteRune(' ')
 	query.WriteString(pgd.Where(where))
+
+	if len(orderBy) > 0 {
+		query.WriteRune(' ')
+		query.WriteString(pgd.OrderBy(orderBy))
+	}
+
 	query.WriteRune(' ')
 	query.WriteString(pgd.Limit(limit))
 
@@ -53,8 +53,17 @@ func (sd SQLServerDialect) Where(where string) string {
 	return fmt.Sprintf("WHERE %s", where)
 }
 
+// OrderBy clause
+func (sd SQLServerDialect) OrderBy(orderBy []string) string {
+	if len(orderBy) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("ORDER BY %s", strings.Join(orderBy, ", "))
+}
+
 // Select clause
-func (sd SQLServerDialect) Select(tableName string, schemaName string, where string, distinct bool, columns ...ColumnExportDefinition) string {
+func (sd SQLServerDialect) Select(tableName string, schemaName string, where string, distinct bool, orderBy []string, columns ...ColumnExportDefinition) string {
 	var query strings.Builder
 
 	query.WriteString("SELECT ")
@@ -81,11 +90,16 @@ func (sd SQLServerDialect) Select(tableName string, schemaName string, where str
 	query.WriteRune(' ')
 	query.WriteString(sd.Where(where))
 
+	if len(orderBy) > 0 {
+		query.WriteRune(' ')
+		query.WriteString(sd.OrderBy(orderBy))
+	}
+
 	return query.String()
 }
 
 // SelectLimit clause
-func (sd SQLServerDialect) SelectLimit(tableName string, schemaName string, where string, distinct bool, limit uint, columns ...ColumnExportDefinition) string {
+func (sd SQLServerDialect) SelectLimit(tableName string, schemaName string, where string, distinct bool, orderBy []string, limit uint, columns ...ColumnExportDefinition) string {
 	var query strings.Builder
 
 	query.WriteString("SELECT ")
@@ -115,6 +129,11 @@ func (sd SQLServerDialect) SelectLimit(tableName string, schemaName string, wher
 	query.WriteRune(' ')
 	query.WriteString(sd.Where(where))
 
+	if len(orderBy) > 0 {
+		query.WriteRune(' ')
+		query.WriteString(sd.OrderBy(orderBy))
+	}
+
 	return query.String()
 }
 
@@ -150,15 +169,27 @@ func (sd SQLServerDialect) EmptyTest(column string) string {
 
 // EnableConstraintsStatement generate statments to activate constraintes
 func (sd SQLServerDialect) EnableConstraintsStatement(tableName string) string {
-	return fmt.Sprintf("ALTER TABLE %s CHECK CONSTRAINT ALL", sd.Quote(tableName))
+	schemaAndTable := strings.Split(tableName, ".")
+	if len(schemaAndTable) == 1 {
+		return fmt.Sprintf("ALTER TABLE %s CHECK CONSTRAINT ALL", sd.Quote(tableName))
+	}
+	return fmt.Sprintf("ALTER TABLE %s CHECK CONSTRAINT ALL", sd.Quote(schemaAndTable[0])+"."+sd.Quote(schemaAndTable[1]))
 }
 
 // DisableConstraintsStatement generate statments to deactivate constraintes
 func (sd SQLServerDialect) DisableConstraintsStatement(tableName string) string {
-	return fmt.Sprintf("ALTER TABLE %s NOCHECK CONSTRAINT ALL", sd.Quote(tableName))
+	schemaAndTable := strings.Split(tableName, ".")
+	if len(schemaAndTable) == 1 {
+		return fmt.Sprintf("ALTER TABLE %s NOCHECK CONSTRAINT ALL", sd.Quote(tableName))
+	}
+	return fmt.Sprintf("ALTER TABLE %s NOCHECK CONSTRAINT ALL", sd.Quote(schemaAndTable[0])+"."+sd.Quote(schemaAndTable[1]))
 }
 
 // TruncateStatement generate statement to truncat table content
 func (sd SQLServerDialect) TruncateStatement(tableName string) string {
-	return fmt.Sprintf("TRUNCATE TABLE %s", sd.Quote(tableName))
+	schemaAndTable := strings.Split(tableName, ".")
+	if len(schemaAndTable) == 1 {
+		return fmt.Sprintf("TRUNCATE TABLE %s", sd.Quote(tableName))
+	}
+	return fmt.Sprintf("TRUNCATE TABLE %s", sd.Quote(schemaAndTable[0])+"."+sd.Quote(schemaAndTable[1]))
 }
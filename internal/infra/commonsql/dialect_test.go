@@ -16,7 +16,7 @@ func TestSQLServerDialect_Select(t *testing.T) {
 	columns := []ColumnExportDefinition{{Name: "column1"}, {Name: "column2"}}
 	expectedResult := "SELECT DISTINCT [column1], [column2] FROM [dbo].[MyTable] WHERE column1 = 1"
 
-	result := dialect.Select(tableName, schemaName, whereClause, distinct, columns...)
+	result := dialect.Select(tableName, schemaName, whereClause, distinct, nil, columns...)
 
 	assert.Equal(t, expectedResult, result)
 }
@@ -31,7 +31,7 @@ func TestSQLServerDialect_SelectLimit(t *testing.T) {
 	limit := uint(10)
 	expectedResult := "SELECT TOP 10 * FROM [dbo].[MyTable] WHERE column1 = 1"
 
-	result := dialect.SelectLimit(tableName, schemaName, whereClause, distinct, limit)
+	result := dialect.SelectLimit(tableName, schemaName, whereClause, distinct, nil, limit)
 
 	assert.Equal(t, expectedResult, result)
 }
@@ -61,7 +61,7 @@ func TestPostgresDialect_Select(t *testing.T) {
 	columns := []ColumnExportDefinition{{Name: "column1"}, {Name: "column2"}}
 	expectedResult := "SELECT DISTINCT \"column1\", \"column2\" FROM \"dbo\".\"MyTable\" WHERE column1 = 1"
 
-	result := dialect.Select(tableName, schemaName, whereClause, distinct, columns...)
+	result := dialect.Select(tableName, schemaName, whereClause, distinct, nil, columns...)
 
 	assert.Equal(t, expectedResult, result)
 }
@@ -77,7 +77,53 @@ func TestPostgresDialect_SelectLimit(t *testing.T) {
 	expectedResult := "SELECT \"column1\", \"column2\" FROM \"dbo\".\"MyTable\" WHERE column1 = 1 LIMIT 10"
 	columns := []ColumnExportDefinition{{Name: "column1"}, {Name: "column2"}}
 
-	result := dialect.SelectLimit(tableName, schemaName, whereClause, distinct, limit, columns...)
+	result := dialect.SelectLimit(tableName, schemaName, whereClause, distinct, nil, limit, columns...)
 
 	assert.Equal(t, expectedResult, result)
 }
+
+func TestSQLServerDialect_SelectLimitOrderBy(t *testing.T) {
+	dialect := SQLServerDialect{}
+
+	result := dialect.SelectLimit("MyTable", "dbo", "column1 = 1", false, []string{"column2 DESC"}, 10)
+
+	assert.Equal(t, "SELECT TOP 10 * FROM [dbo].[MyTable] WHERE column1 = 1 ORDER BY column2 DESC", result)
+}
+
+func TestPostgresDialect_SelectLimitOrderBy(t *testing.T) {
+	dialect := PostgresDialect{}
+
+	result := dialect.SelectLimit("MyTable", "dbo", "column1 = 1", false, []string{"column2 DESC", "column1"}, 10)
+
+	assert.Equal(t, "SELECT * FROM \"dbo\".\"MyTable\" WHERE column1 = 1 ORDER BY column2 DESC, column1 LIMIT 10", result)
+}
+
+func TestOracleDialect_SelectLimitOrderBy(t *testing.T) {
+	dialect := OracleDialect{}
+
+	result := dialect.SelectLimit("MyTable", "", "column1 = 1", false, []string{"column2 DESC"}, 10)
+
+	assert.Equal(t, "SELECT * FROM (SELECT * FROM \"MyTable\" WHERE column1 = 1 ORDER BY column2 DESC) WHERE rownum <= 10", result)
+}
+
+func TestDb2Dialect_SelectLimitOrderBy(t *testing.T) {
+	dialect := Db2Dialect{}
+
+	result := dialect.SelectLimit("MyTable", "", "column1 = 1", false, []string{"column2 DESC"}, 10)
+
+	assert.Equal(t, "SELECT * FROM \"MyTable\" WHERE column1 = 1 ORDER BY column2 DESC  FETCH FIRST 10 ROWS ONLY", result)
+}
+
+func TestSQLServerDialect_QualifiedTableStatements(t *testing.T) {
+	dialect := SQLServerDialect{}
+
+	assert.Equal(t, "TRUNCATE TABLE [sales].[MyTable]", dialect.TruncateStatement("sales.MyTable"))
+	assert.Equal(t, "ALTER TABLE [sales].[MyTable] NOCHECK CONSTRAINT ALL", dialect.DisableConstraintsStatement("sales.MyTable"))
+	assert.Equal(t, "ALTER TABLE [MyTable] CHECK CONSTRAINT ALL", dialect.EnableConstraintsStatement("MyTable"))
+}
+
+func TestMariadbDialect_QualifiedTruncate(t *testing.T) {
+	dialect := MariadbDialect{}
+
+	assert.Equal(t, "TRUNCATE TABLE `sales`.`MyTable`", dialect.TruncateStatement("sales.MyTable"))
+}
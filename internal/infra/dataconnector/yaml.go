@@ -41,6 +41,7 @@ type YAMLDataConnector struct {
 	URL      string           `yaml:"url"`
 	ReadOnly bool             `yaml:"readonly"`
 	Schema   string           `yaml:"schema,omitempty"`
+	Schemas  []string         `yaml:"schemas,omitempty"`
 	User     *YAMLValueHolder `yaml:"user,omitempty"`
 	Password *YAMLValueHolder `yaml:"password,omitempty"`
 }
@@ -73,6 +74,7 @@ func (s YAMLStorage) List() ([]dataconnector.DataConnector, *dataconnector.Error
 			URL:      ym.URL,
 			ReadOnly: ym.ReadOnly,
 			Schema:   ym.Schema,
+			Schemas:  ym.Schemas,
 		}
 		if ym.User != nil {
 			if ym.User.Value != nil {
@@ -105,6 +107,7 @@ func (s YAMLStorage) Store(m *dataconnector.DataConnector) *dataconnector.Error
 		URL:      m.URL,
 		ReadOnly: m.ReadOnly,
 		Schema:   m.Schema,
+		Schemas:  m.Schemas,
 		User:     nil,
 		Password: nil,
 	}
@@ -0,0 +1,81 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+package id
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cgi-fr/lino/pkg/id"
+)
+
+// lookUpLabel returns the arrow showing the lookup directions of the relation.
+func lookUpLabel(rel id.IngressRelation) string {
+	switch {
+	case rel.LookUpChild() && rel.LookUpParent():
+		return `↔`
+	case rel.LookUpChild():
+		return `→`
+	case rel.LookUpParent():
+		return `←`
+	}
+	return ""
+}
+
+// nodeNames returns an identifier usable in any diagram language for each table of the plan.
+func nodeNames(ep id.PullerPlan) map[string]string {
+	result := map[string]string{}
+	for i, table := range sortTables(ep.Tables()) {
+		result[table.Name()] = fmt.Sprintf("t%d", i)
+	}
+	return result
+}
+
+// stepGroups returns the tables pulled by each step of the plan (a table pulled by several steps belongs to the first
+// one), and the tables pulled by no step.
+func stepGroups(ep id.PullerPlan) ([][]id.Table, []id.Table) {
+	groups := [][]id.Table{}
+	placed := map[string]bool{}
+	for i := uint(0); i < ep.Len(); i++ {
+		group := []id.Table{}
+		for _, table := range sortTables(ep.Step(i).Tables()) {
+			if !placed[table.Name()] {
+				placed[table.Name()] = true
+				group = append(group, table)
+			}
+		}
+		groups = append(groups, group)
+	}
+
+	others := []id.Table{}
+	for _, table := range sortTables(ep.Tables()) {
+		if !placed[table.Name()] {
+			others = append(others, table)
+		}
+	}
+
+	return groups, others
+}
+
+func sortTables(list id.TableList) []id.Table {
+	result := make([]id.Table, 0, list.Len())
+	for i := uint(0); i < list.Len(); i++ {
+		result = append(result, list.Table(i))
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name() < result[j].Name() })
+	return result
+}
@@ -0,0 +1,122 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+package id
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/cgi-fr/lino/pkg/id"
+	"github.com/stretchr/testify/assert"
+)
+
+type planStorage struct {
+	descriptor id.IngressDescriptor
+}
+
+func (s planStorage) Store(id.IngressDescriptor) *id.Error { return nil }
+
+func (s planStorage) Read() (id.IngressDescriptor, *id.Error) { return s.descriptor, nil }
+
+func ingressRelation(name, parent string, lookUpParent bool, child string, lookUpChild bool) id.IngressRelation {
+	return id.NewIngressRelation(id.NewRelation(name, id.NewTable(parent), id.NewTable(child)), lookUpParent, lookUpChild, "", "", []string{}, []string{}, []string{}, []string{}, 0)
+}
+
+// customer -> store, then the cycle store <-> staff, rental is not pulled
+func exportPlan(t *testing.T, exporter id.Exporter) {
+	t.Helper()
+	descriptor := id.NewIngressDescriptor(id.NewTable("customer"), []string{}, []string{}, id.NewIngressRelationList([]id.IngressRelation{
+		ingressRelation("customer_store", "store", true, "customer", false),
+		ingressRelation("store_manager", "staff", false, "store", true),
+		ingressRelation("staff_store", "store", false, "staff", true),
+		ingressRelation("rental_customer", "customer", true, "rental", false),
+	}))
+
+	assert.Nil(t, id.Export(planStorage{descriptor}, exporter))
+}
+
+func TestMermaidExporter(t *testing.T) {
+	out := &strings.Builder{}
+	exportPlan(t, NewMermaidExporter(out))
+
+	assert.Equal(t, `flowchart TD
+    t0["customer"]
+    subgraph cluster1 [" "]
+        t2["staff"]
+        t3["store"]
+    end
+    t1["rental"]
+    t3 -->|"←"| t0
+    t2 -->|"→"| t3
+    t3 -->|"→"| t2
+    t0 -->|"←"| t1
+`, out.String())
+}
+
+func TestPlantUMLExporter(t *testing.T) {
+	out := &strings.Builder{}
+	exportPlan(t, NewPlantUMLExporter(out))
+
+	assert.Equal(t, `@startuml
+rectangle "customer" as t0
+rectangle " " as cluster1 {
+  rectangle "staff" as t2
+  rectangle "store" as t3
+}
+rectangle "rental" as t1
+t3 --> t0 : ←
+t2 --> t3 : →
+t3 --> t2 : →
+t0 --> t1 : ←
+@enduml
+`, out.String())
+}
+
+func TestSVGExporter(t *testing.T) {
+	out := &strings.Builder{}
+	exportPlan(t, NewSVGExporter(out))
+
+	elements := map[string]int{}
+	texts := []string{}
+	decoder := xml.NewDecoder(strings.NewReader(out.String()))
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+		if err != nil {
+			return
+		}
+		switch e := token.(type) {
+		case xml.StartElement:
+			elements[e.Name.Local]++
+		case xml.CharData:
+			if text := strings.TrimSpace(string(e)); text != "" {
+				texts = append(texts, text)
+			}
+		}
+	}
+
+	assert.Equal(t, 1, elements["svg"])
+	assert.Equal(t, 5, elements["rect"], "4 tables and 1 cycle")
+	assert.Equal(t, 2, elements["line"])
+	assert.Equal(t, 3, elements["path"], "arrow head and 2 relations of the cycle")
+	assert.ElementsMatch(t, []string{"customer", "rental", "staff", "store", "←", "→", "→", "←"}, texts)
+}
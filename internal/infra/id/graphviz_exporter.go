@@ -80,17 +80,7 @@ func (e *GraphVizExporter) Export(ep id.PullerPlan) *id.Error {
 	}
 	for i := uint(0); i < ep.Relations().Len(); i++ {
 		rel := ep.Relations().Relation(i)
-		var relname string
-		switch {
-		case rel.LookUpChild() && rel.LookUpParent():
-			relname = `↔`
-		case rel.LookUpChild():
-			relname = `→`
-		case rel.LookUpParent():
-			relname = `←`
-		}
-
-		err = graphViz.AddEdge(strconv.Quote(rel.Parent().Name()), strconv.Quote(rel.Child().Name()), true, map[string]string{"label": relname})
+		err = graphViz.AddEdge(strconv.Quote(rel.Parent().Name()), strconv.Quote(rel.Child().Name()), true, map[string]string{"label": lookUpLabel(rel)})
 		if err != nil {
 			return &id.Error{Description: err.Error()}
 		}
@@ -0,0 +1,77 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+package id
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cgi-fr/lino/pkg/id"
+)
+
+// MermaidExporter export to a Mermaid flowchart.
+type MermaidExporter struct {
+	writer io.Writer
+}
+
+// NewMermaidExporter create a new MermaidExporter writing to w
+func NewMermaidExporter(w io.Writer) *MermaidExporter {
+	return &MermaidExporter{w}
+}
+
+// Export write the flowchart, tables pulled by the same step are grouped in a subgraph.
+func (e *MermaidExporter) Export(ep id.PullerPlan) *id.Error {
+	nodes := nodeNames(ep)
+	groups, others := stepGroups(ep)
+
+	sb := &strings.Builder{}
+	fmt.Fprintln(sb, "flowchart TD")
+	for i, group := range groups {
+		switch {
+		case len(group) > 1:
+			fmt.Fprintf(sb, "    subgraph cluster%d [\" \"]\n", i)
+			for _, table := range group {
+				fmt.Fprintf(sb, "        %s[%s]\n", nodes[table.Name()], mermaidQuote(table.Name()))
+			}
+			fmt.Fprintln(sb, "    end")
+		case len(group) == 1:
+			fmt.Fprintf(sb, "    %s[%s]\n", nodes[group[0].Name()], mermaidQuote(group[0].Name()))
+		}
+	}
+	for _, table := range others {
+		fmt.Fprintf(sb, "    %s[%s]\n", nodes[table.Name()], mermaidQuote(table.Name()))
+	}
+	for i := uint(0); i < ep.Relations().Len(); i++ {
+		rel := ep.Relations().Relation(i)
+		if label := lookUpLabel(rel); label != "" {
+			fmt.Fprintf(sb, "    %s -->|%s| %s\n", nodes[rel.Parent().Name()], mermaidQuote(label), nodes[rel.Child().Name()])
+		} else {
+			fmt.Fprintf(sb, "    %s --> %s\n", nodes[rel.Parent().Name()], nodes[rel.Child().Name()])
+		}
+	}
+
+	if _, err := io.WriteString(e.writer, sb.String()); err != nil {
+		return &id.Error{Description: err.Error()}
+	}
+
+	return nil
+}
+
+func mermaidQuote(text string) string {
+	return `"` + strings.ReplaceAll(text, `"`, "#quot;") + `"`
+}
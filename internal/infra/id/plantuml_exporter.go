@@ -0,0 +1,78 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+package id
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cgi-fr/lino/pkg/id"
+)
+
+// PlantUMLExporter export to a PlantUML diagram.
+type PlantUMLExporter struct {
+	writer io.Writer
+}
+
+// NewPlantUMLExporter create a new PlantUMLExporter writing to w
+func NewPlantUMLExporter(w io.Writer) *PlantUMLExporter {
+	return &PlantUMLExporter{w}
+}
+
+// Export write the diagram, tables pulled by the same step are grouped in a rectangle.
+func (e *PlantUMLExporter) Export(ep id.PullerPlan) *id.Error {
+	nodes := nodeNames(ep)
+	groups, others := stepGroups(ep)
+
+	sb := &strings.Builder{}
+	fmt.Fprintln(sb, "@startuml")
+	for i, group := range groups {
+		switch {
+		case len(group) > 1:
+			fmt.Fprintf(sb, "rectangle \" \" as cluster%d {\n", i)
+			for _, table := range group {
+				fmt.Fprintf(sb, "  rectangle %s as %s\n", plantUMLQuote(table.Name()), nodes[table.Name()])
+			}
+			fmt.Fprintln(sb, "}")
+		case len(group) == 1:
+			fmt.Fprintf(sb, "rectangle %s as %s\n", plantUMLQuote(group[0].Name()), nodes[group[0].Name()])
+		}
+	}
+	for _, table := range others {
+		fmt.Fprintf(sb, "rectangle %s as %s\n", plantUMLQuote(table.Name()), nodes[table.Name()])
+	}
+	for i := uint(0); i < ep.Relations().Len(); i++ {
+		rel := ep.Relations().Relation(i)
+		if label := lookUpLabel(rel); label != "" {
+			fmt.Fprintf(sb, "%s --> %s : %s\n", nodes[rel.Parent().Name()], nodes[rel.Child().Name()], label)
+		} else {
+			fmt.Fprintf(sb, "%s --> %s\n", nodes[rel.Parent().Name()], nodes[rel.Child().Name()])
+		}
+	}
+	fmt.Fprintln(sb, "@enduml")
+
+	if _, err := io.WriteString(e.writer, sb.String()); err != nil {
+		return &id.Error{Description: err.Error()}
+	}
+
+	return nil
+}
+
+func plantUMLQuote(text string) string {
+	return `"` + strings.ReplaceAll(text, `"`, "'") + `"`
+}
@@ -0,0 +1,217 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+package id
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"math"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cgi-fr/lino/pkg/id"
+)
+
+const (
+	svgCharWidth      = 8
+	svgNodeHeight     = 30
+	svgNodePadding    = 10
+	svgClusterPadding = 10
+	svgHorizontalGap  = 30
+	svgVerticalGap    = 70
+	svgCurve          = 25
+	svgShift          = 5
+	svgMargin         = 40
+)
+
+// SVGExporter export to a SVG image without any external tool.
+type SVGExporter struct {
+	writer io.Writer
+}
+
+// NewSVGExporter create a new SVGExporter writing to w
+func NewSVGExporter(w io.Writer) *SVGExporter {
+	return &SVGExporter{w}
+}
+
+type svgBox struct {
+	x, y, w, h float64
+}
+
+func (b svgBox) cx() float64 { return b.x + b.w/2 }
+func (b svgBox) cy() float64 { return b.y + b.h/2 }
+
+// clip returns the point where the segment from the center of the box to (x, y) crosses the border of the box.
+func (b svgBox) clip(x, y float64) (float64, float64) {
+	dx, dy := x-b.cx(), y-b.cy()
+	t := math.Inf(1)
+	if dx != 0 {
+		t = math.Min(t, b.w/2/math.Abs(dx))
+	}
+	if dy != 0 {
+		t = math.Min(t, b.h/2/math.Abs(dy))
+	}
+	if math.IsInf(t, 1) {
+		return b.cx(), b.cy()
+	}
+	return b.cx() + t*dx, b.cy() + t*dy
+}
+
+// Export lays out each step in the row below the step it follows, tables pulled by the same step are grouped in a
+// dashed rectangle.
+func (e *SVGExporter) Export(ep id.PullerPlan) *id.Error {
+	rows := svgRows(ep)
+
+	boxes := map[string]svgBox{}
+	clusters := []svgBox{}
+	width := 0.0
+	for r, row := range rows {
+		y := float64(svgMargin + r*(svgNodeHeight+svgVerticalGap))
+		x := float64(svgMargin)
+		for _, group := range row {
+			if len(group) > 1 {
+				x += svgClusterPadding
+			}
+			start := x
+			for _, table := range group {
+				w := float64(utf8.RuneCountInString(table.Name())*svgCharWidth + 2*svgNodePadding)
+				boxes[table.Name()] = svgBox{x, y, w, svgNodeHeight}
+				x += w + svgHorizontalGap
+			}
+			if len(group) > 1 {
+				end := x - svgHorizontalGap + svgClusterPadding
+				clusters = append(clusters, svgBox{start - svgClusterPadding, y - svgClusterPadding, end - start + svgClusterPadding, svgNodeHeight + 2*svgClusterPadding})
+				x = end + svgHorizontalGap
+			}
+		}
+		width = math.Max(width, x-svgHorizontalGap+svgMargin)
+	}
+	height := float64(2*svgMargin + len(rows)*svgNodeHeight + (len(rows)-1)*svgVerticalGap)
+	if len(rows) == 0 {
+		width, height = 2*svgMargin, 2*svgMargin
+	}
+
+	sb := &strings.Builder{}
+	fmt.Fprintln(sb, `<?xml version="1.0" encoding="UTF-8"?>`)
+	fmt.Fprintf(sb, `<svg xmlns="http://www.w3.org/2000/svg" width="%.0f" height="%.0f" viewBox="0 0 %.0f %.0f" font-family="Helvetica,Arial,sans-serif" font-size="14">`+"\n", width, height, width, height)
+	fmt.Fprintln(sb, `<defs><marker id="arrow" viewBox="0 0 10 10" refX="10" refY="5" markerWidth="8" markerHeight="8" orient="auto"><path d="M 0 0 L 10 5 L 0 10 z"/></marker></defs>`)
+
+	for _, cluster := range clusters {
+		fmt.Fprintf(sb, `<rect x="%.1f" y="%.1f" width="%.1f" height="%.1f" fill="none" stroke="gray" stroke-dasharray="4"/>`+"\n", cluster.x, cluster.y, cluster.w, cluster.h)
+	}
+
+	labels := &strings.Builder{}
+	for i := uint(0); i < ep.Relations().Len(); i++ {
+		rel := ep.Relations().Relation(i)
+		from, to := boxes[rel.Parent().Name()], boxes[rel.Child().Name()]
+
+		var labelX, labelY float64
+		switch {
+		case rel.Parent().Name() == rel.Child().Name():
+			x := from.x + from.w
+			fmt.Fprintf(sb, `<path d="M %.1f %.1f C %.1f %.1f, %.1f %.1f, %.1f %.1f" fill="none" stroke="black" marker-end="url(#arrow)"/>`+"\n",
+				x, from.y+8, x+35, from.y-10, x+35, from.y+from.h+10, x, from.y+from.h-8)
+			labelX, labelY = x+35, from.cy()
+		case from.y == to.y:
+			// relations inside a row go below the tables from left to right, and above from right to left
+			y, curve := from.y+from.h, float64(svgCurve)
+			if from.cx() > to.cx() {
+				y, curve = from.y, -curve
+			}
+			fmt.Fprintf(sb, `<path d="M %.1f %.1f Q %.1f %.1f, %.1f %.1f" fill="none" stroke="black" marker-end="url(#arrow)"/>`+"\n",
+				from.cx(), y, (from.cx()+to.cx())/2, y+2*curve, to.cx(), y)
+			labelX, labelY = (from.cx()+to.cx())/2, y+curve
+		default:
+			x1, y1 := from.clip(to.cx(), to.cy())
+			x2, y2 := to.clip(from.cx(), from.cy())
+			// shift the line to its right so that the relations in both directions between two tables don't overlap
+			length := math.Hypot(x2-x1, y2-y1)
+			nx, ny := -(y2-y1)/length, (x2-x1)/length
+			x1, y1, x2, y2 = x1+svgShift*nx, y1+svgShift*ny, x2+svgShift*nx, y2+svgShift*ny
+			fmt.Fprintf(sb, `<line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f" stroke="black" marker-end="url(#arrow)"/>`+"\n", x1, y1, x2, y2)
+			labelX, labelY = (x1+x2)/2+2*svgShift*nx, (y1+y2)/2+2*svgShift*ny
+		}
+
+		if label := lookUpLabel(rel); label != "" {
+			fmt.Fprintf(labels, `<text x="%.1f" y="%.1f" text-anchor="middle" dominant-baseline="central" stroke="white" stroke-width="4" paint-order="stroke">%s</text>`+"\n", labelX, labelY, label)
+		}
+	}
+
+	for _, table := range sortTables(ep.Tables()) {
+		box := boxes[table.Name()]
+		fmt.Fprintf(sb, `<rect x="%.1f" y="%.1f" width="%.1f" height="%.1f" rx="4" fill="white" stroke="black"/>`+"\n", box.x, box.y, box.w, box.h)
+		fmt.Fprintf(sb, `<text x="%.1f" y="%.1f" text-anchor="middle" dominant-baseline="central">%s</text>`+"\n", box.cx(), box.cy(), html.EscapeString(table.Name()))
+	}
+
+	sb.WriteString(labels.String())
+	fmt.Fprintln(sb, "</svg>")
+
+	if _, err := io.WriteString(e.writer, sb.String()); err != nil {
+		return &id.Error{Description: err.Error()}
+	}
+
+	return nil
+}
+
+// svgRows returns the rows of the image, each row lists the groups of tables pulled by the steps following a step of
+// the previous row. Tables pulled by no step are in the row below a related table.
+func svgRows(ep id.PullerPlan) [][][]id.Table {
+	groups, others := stepGroups(ep)
+
+	rows := [][][]id.Table{}
+	place := func(group []id.Table, row int) {
+		for len(rows) <= row {
+			rows = append(rows, [][]id.Table{})
+		}
+		rows[row] = append(rows[row], group)
+	}
+
+	stepRows := map[uint]int{}
+	tableRows := map[string]int{}
+	for i, group := range groups {
+		step := ep.Step(uint(i))
+		row := 0
+		if step.PreviousStep() != 0 {
+			row = stepRows[step.PreviousStep()] + 1
+		}
+		stepRows[step.Index()] = row
+		if len(group) == 0 {
+			continue
+		}
+		for _, table := range group {
+			tableRows[table.Name()] = row
+		}
+		place(group, row)
+	}
+
+	last := len(rows)
+	for _, table := range others {
+		row := last
+		for i := uint(0); i < ep.Relations().Len(); i++ {
+			rel := ep.Relations().Relation(i)
+			for _, related := range []string{rel.Parent().Name(), rel.Child().Name()} {
+				if r, ok := tableRows[related]; ok && (rel.Parent().Name() == table.Name() || rel.Child().Name() == table.Name()) && r+1 < row {
+					row = r + 1
+				}
+			}
+		}
+		place([]id.Table{table}, row)
+	}
+
+	return rows
+}
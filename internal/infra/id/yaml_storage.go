@@ -19,7 +19,10 @@
 
 import (
 	"bytes"
+	"fmt"
 	"os"
+	"path/filepath"
+	"slices"
 
 	"github.com/cgi-fr/lino/pkg/id"
 	"gopkg.in/yaml.v3"
@@ -33,8 +36,10 @@ type YAMLStructure struct {
 
 // YAMLIngressDescriptor defines how to store an ingress descriptor in YAML format.
 type YAMLIngressDescriptor struct {
+	Include    []string       `yaml:"include,omitempty"`
 	StartTable string         `yaml:"startTable"`
 	Select     []string       `yaml:"select"`
+	OrderBy    []string       `yaml:"orderBy,omitempty"`
 	Relations  []YAMLRelation `yaml:"relations"`
 }
 
@@ -47,10 +52,12 @@ type YAMLRelation struct {
 
 // YAMLTable defines how to store a table in YAML format.
 type YAMLTable struct {
-	Name   string   `yaml:"name"`
-	Lookup bool     `yaml:"lookup"`
-	Where  string   `yaml:"where,omitempty"`
-	Select []string `yaml:"select,omitempty"`
+	Name    string   `yaml:"name"`
+	Lookup  bool     `yaml:"lookup"`
+	Where   string   `yaml:"where,omitempty"`
+	Select  []string `yaml:"select,omitempty"`
+	OrderBy []string `yaml:"orderBy,omitempty"`
+	Limit   uint     `yaml:"limit,omitempty"`
 }
 
 // YAMLStorage provides storage in a local YAML file
@@ -75,17 +82,28 @@ func (s *YAMLStorage) Store(id id.IngressDescriptor) *id.Error {
 		relation := list.Relation(i)
 		relations = append(relations, YAMLRelation{
 			Name:   relation.Name(),
-			Parent: YAMLTable{Name: relation.Parent().Name(), Lookup: relation.LookUpParent(), Where: relation.WhereParent(), Select: relation.SelectParent()},
-			Child:  YAMLTable{Name: relation.Child().Name(), Lookup: relation.LookUpChild(), Where: relation.WhereChild(), Select: relation.SelectChild()},
+			Parent: YAMLTable{Name: relation.Parent().Name(), Lookup: relation.LookUpParent(), Where: relation.WhereParent(), Select: relation.SelectParent(), OrderBy: relation.OrderParent()},
+			Child:  YAMLTable{Name: relation.Child().Name(), Lookup: relation.LookUpChild(), Where: relation.WhereChild(), Select: relation.SelectChild(), OrderBy: relation.OrderChild(), Limit: relation.LimitChild()},
 		})
 	}
 
 	structure.IngressDescriptor = YAMLIngressDescriptor{
 		StartTable: id.StartTable().Name(),
 		Select:     id.Select(),
+		OrderBy:    id.OrderBy(),
 		Relations:  relations,
 	}
 
+	// keep the includes of the file, only the differences with the included descriptors are stored
+	if existing, err := readFile(s.filename); err == nil && len(existing.IngressDescriptor.Include) > 0 {
+		base, err := resolveIncludes(existing.IngressDescriptor.Include, filepath.Dir(s.filename), []string{absPath(s.filename)})
+		if err != nil {
+			return err
+		}
+		structure.IngressDescriptor = overrides(base, structure.IngressDescriptor)
+		structure.IngressDescriptor.Include = existing.IngressDescriptor.Include
+	}
+
 	err := writeFile(&structure, s.filename)
 	if err != nil {
 		return err
@@ -95,13 +113,13 @@ func (s *YAMLStorage) Store(id id.IngressDescriptor) *id.Error {
 }
 
 func (s *YAMLStorage) Read() (id.IngressDescriptor, *id.Error) {
-	structure, err := readFile(s.filename)
+	descriptor, err := readDescriptor(s.filename, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	relations := []id.IngressRelation{}
-	for _, relation := range structure.IngressDescriptor.Relations {
+	for _, relation := range descriptor.Relations {
 		relations = append(relations,
 			id.NewIngressRelation(
 				id.NewRelation(
@@ -112,11 +130,124 @@ func (s *YAMLStorage) Read() (id.IngressDescriptor, *id.Error) {
 				relation.Parent.Lookup, relation.Child.Lookup,
 				relation.Parent.Where, relation.Child.Where,
 				relation.Parent.Select, relation.Child.Select,
+				relation.Parent.OrderBy, relation.Child.OrderBy,
+				relation.Child.Limit,
 			),
 		)
 	}
 
-	return id.NewIngressDescriptor(id.NewTable(structure.IngressDescriptor.StartTable), structure.IngressDescriptor.Select, id.NewIngressRelationList(relations)), nil
+	return id.NewIngressDescriptor(id.NewTable(descriptor.StartTable), descriptor.Select, descriptor.OrderBy, id.NewIngressRelationList(relations)), nil
+}
+
+// readDescriptor reads the ingress descriptor of the file merged with its includes, parents are the files including it.
+func readDescriptor(filename string, parents []string) (YAMLIngressDescriptor, *id.Error) {
+	path := absPath(filename)
+	if slices.Contains(parents, path) {
+		return YAMLIngressDescriptor{}, &id.Error{Description: fmt.Sprintf("ingress descriptor %s includes itself", filename)}
+	}
+
+	structure, err := readFile(filename)
+	if err != nil {
+		return YAMLIngressDescriptor{}, err
+	}
+
+	descriptor := structure.IngressDescriptor
+	if len(descriptor.Include) == 0 {
+		return descriptor, nil
+	}
+
+	base, err := resolveIncludes(descriptor.Include, filepath.Dir(filename), append(slices.Clone(parents), path))
+	if err != nil {
+		return YAMLIngressDescriptor{}, err
+	}
+
+	return merge(base, descriptor), nil
+}
+
+// resolveIncludes merges the included descriptors in order, paths are relative to dir.
+func resolveIncludes(includes []string, dir string, parents []string) (YAMLIngressDescriptor, *id.Error) {
+	result := YAMLIngressDescriptor{}
+
+	for _, include := range includes {
+		if !filepath.IsAbs(include) {
+			include = filepath.Join(dir, include)
+		}
+
+		included, err := readDescriptor(include, parents)
+		if err != nil {
+			return YAMLIngressDescriptor{}, err
+		}
+
+		result = merge(result, included)
+	}
+
+	return result, nil
+}
+
+// merge overrides the base descriptor with the start table, select and order of the descriptor if they are set,
+// a relation of the descriptor replaces the relation of the base with the same name.
+func merge(base YAMLIngressDescriptor, descriptor YAMLIngressDescriptor) YAMLIngressDescriptor {
+	result := YAMLIngressDescriptor{
+		StartTable: base.StartTable,
+		Select:     base.Select,
+		OrderBy:    base.OrderBy,
+		Relations:  slices.Clone(base.Relations),
+	}
+
+	if descriptor.StartTable != "" {
+		result.StartTable = descriptor.StartTable
+	}
+
+	if len(descriptor.Select) > 0 {
+		result.Select = descriptor.Select
+	}
+
+	if len(descriptor.OrderBy) > 0 {
+		result.OrderBy = descriptor.OrderBy
+	}
+
+	for _, relation := range descriptor.Relations {
+		index := slices.IndexFunc(result.Relations, func(r YAMLRelation) bool { return r.Name == relation.Name })
+		if index < 0 {
+			result.Relations = append(result.Relations, relation)
+		} else {
+			result.Relations[index] = relation
+		}
+	}
+
+	return result
+}
+
+// overrides keeps the relations of the descriptor that are not in the base descriptor or differ from it.
+func overrides(base YAMLIngressDescriptor, descriptor YAMLIngressDescriptor) YAMLIngressDescriptor {
+	result := descriptor
+	result.Relations = []YAMLRelation{}
+
+	for _, relation := range descriptor.Relations {
+		index := slices.IndexFunc(base.Relations, func(r YAMLRelation) bool { return r.Name == relation.Name })
+		if index < 0 || !equalRelation(base.Relations[index], relation) {
+			result.Relations = append(result.Relations, relation)
+		}
+	}
+
+	return result
+}
+
+func equalRelation(a YAMLRelation, b YAMLRelation) bool {
+	return a.Name == b.Name && equalTable(a.Parent, b.Parent) && equalTable(a.Child, b.Child)
+}
+
+func equalTable(a YAMLTable, b YAMLTable) bool {
+	return a.Name == b.Name && a.Lookup == b.Lookup && a.Where == b.Where && a.Limit == b.Limit &&
+		slices.Equal(a.Select, b.Select) && slices.Equal(a.OrderBy, b.OrderBy)
+}
+
+func absPath(filename string) string {
+	path, err := filepath.Abs(filename)
+	if err != nil {
+		return filename
+	}
+	return path
 }
 
 func writeFile(structure *YAMLStructure, filename string) *id.Error {
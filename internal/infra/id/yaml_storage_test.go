@@ -0,0 +1,126 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package id
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cgi-fr/lino/pkg/id"
+	"github.com/stretchr/testify/assert"
+)
+
+const baseDescriptor = `version: v1
+IngressDescriptor:
+  startTable: customer
+  select:
+    - customer_id
+  relations:
+    - name: rental_customer
+      parent:
+        name: customer
+        lookup: false
+      child:
+        name: rental
+        lookup: true
+    - name: payment_customer
+      parent:
+        name: customer
+        lookup: false
+      child:
+        name: payment
+        lookup: true
+`
+
+const overrideDescriptor = `version: v1
+IngressDescriptor:
+  include:
+    - common/base.yaml
+  startTable: customer
+  relations:
+    - name: rental_customer
+      parent:
+        name: customer
+        lookup: false
+      child:
+        name: rental
+        lookup: true
+        where: rental_date >= '${since}'
+`
+
+func writeDescriptors(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	assert.NoError(t, os.Mkdir(filepath.Join(dir, "common"), 0o700))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "common", "base.yaml"), []byte(baseDescriptor), 0o600))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "rentals.yaml"), []byte(overrideDescriptor), 0o600))
+
+	return dir
+}
+
+func TestReadInclude(t *testing.T) {
+	dir := writeDescriptors(t)
+
+	descriptor, err := NewYAMLStorage(filepath.Join(dir, "rentals.yaml")).Read()
+
+	assert.Nil(t, err)
+	assert.Equal(t, "customer", descriptor.StartTable().Name())
+	assert.Equal(t, []string{"customer_id"}, descriptor.Select())
+	assert.Equal(t, uint(2), descriptor.Relations().Len())
+	assert.Equal(t, "rental_date >= '${since}'", descriptor.Relations().Relation(0).WhereChild())
+	assert.Equal(t, "payment_customer", descriptor.Relations().Relation(1).Name())
+}
+
+func TestStoreKeepsInclude(t *testing.T) {
+	dir := writeDescriptors(t)
+	storage := NewYAMLStorage(filepath.Join(dir, "rentals.yaml"))
+
+	descriptor, err := storage.Read()
+	assert.Nil(t, err)
+
+	payment := descriptor.Relations().Relation(1)
+	updated := id.NewIngressDescriptor(descriptor.StartTable(), descriptor.Select(), descriptor.OrderBy(), id.NewIngressRelationList([]id.IngressRelation{
+		descriptor.Relations().Relation(0),
+		id.NewIngressRelation(payment, false, true, "", "amount > 0", nil, nil, nil, nil, 10),
+	}))
+	assert.Nil(t, storage.Store(updated))
+
+	structure, err := readFile(filepath.Join(dir, "rentals.yaml"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"common/base.yaml"}, structure.IngressDescriptor.Include)
+	assert.Len(t, structure.IngressDescriptor.Relations, 2)
+	assert.Equal(t, "amount > 0", structure.IngressDescriptor.Relations[1].Child.Where)
+
+	assert.Nil(t, storage.Store(descriptor))
+
+	structure, err = readFile(filepath.Join(dir, "rentals.yaml"))
+	assert.Nil(t, err)
+	assert.Len(t, structure.IngressDescriptor.Relations, 1)
+}
+
+func TestIncludeCycle(t *testing.T) {
+	dir := t.TempDir()
+	cycle := "version: v1\nIngressDescriptor:\n  include:\n    - cycle.yaml\n"
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "cycle.yaml"), []byte(cycle), 0o600))
+
+	_, err := NewYAMLStorage(filepath.Join(dir, "cycle.yaml")).Read()
+
+	assert.Equal(t, &id.Error{Description: "ingress descriptor " + filepath.Join(dir, "cycle.yaml") + " includes itself"}, err)
+}
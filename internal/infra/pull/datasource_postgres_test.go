@@ -67,3 +67,26 @@ func TestCreateSelectPostgresWithColumns(t *testing.T) {
 	_, err = pgDS.RowReader(aTable, aFilter)
 	assert.Nil(t, err)
 }
+
+func TestCountPostgres(t *testing.T) {
+	aTable := pull.Table{Name: "CUSTOMERS"}
+	aFilter := pull.Filter{Limit: 5, Values: pull.Row{"id": 1}, OrderBy: []string{"id"}}
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.Nil(t, err)
+	defer db.Close() //nolint:errcheck
+
+	mock.ExpectQuery("SELECT COUNT(*) FROM (SELECT * FROM \"CUSTOMERS\" WHERE id=$1) lino_count").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(12))
+
+	pgDS := infra.NewPostgresDataSourceFactory().New("pg://server/name", "")
+
+	err = pgDS.(*infra.SQLDataSource).OpenWithDB(db)
+	assert.Nil(t, err)
+
+	count, err := pgDS.(*infra.SQLDataSource).Count(aTable, aFilter)
+	assert.Nil(t, err)
+	assert.Equal(t, 12, count)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,90 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package pull
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// FileStore writes contents in files named after their SHA-256 hash, so identical contents are written once.
+type FileStore struct {
+	dir string
+	ref string
+}
+
+// NewFileStore creates a new content-addressed file store in the directory dir.
+func NewFileStore(dir string) *FileStore {
+	ref := dir
+	if filepath.IsAbs(dir) {
+		if wd, err := os.Getwd(); err == nil {
+			if rel, err := filepath.Rel(wd, dir); err == nil {
+				ref = rel
+			}
+		}
+	}
+
+	return &FileStore{dir: dir, ref: ref}
+}
+
+// Store writes the content if it does not already exist and returns its path relative to the working directory, even
+// if dir is absolute, the file import of the push command resolves it against its own working directory.
+func (fs *FileStore) Store(content []byte) (string, error) {
+	hash := sha256.Sum256(content)
+	name := hex.EncodeToString(hash[:])
+	path := filepath.Join(fs.dir, name)
+	ref := filepath.ToSlash(filepath.Join(fs.ref, name))
+
+	if _, err := os.Stat(path); err == nil {
+		return ref, nil
+	}
+
+	if err := os.MkdirAll(fs.dir, 0o750); err != nil { //nolint:gomnd
+		return "", fmt.Errorf("%w", err)
+	}
+
+	// write in a temporary file first, parallel workers can store the same content
+	tmp, err := os.CreateTemp(fs.dir, ".tmp-*")
+	if err != nil {
+		return "", fmt.Errorf("%w", err)
+	}
+
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()           //nolint:errcheck,gosec
+		os.Remove(tmp.Name()) //nolint:errcheck,gosec
+
+		return "", fmt.Errorf("%w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name()) //nolint:errcheck,gosec
+
+		return "", fmt.Errorf("%w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name()) //nolint:errcheck,gosec
+
+		return "", fmt.Errorf("%w", err)
+	}
+
+	return ref, nil
+}
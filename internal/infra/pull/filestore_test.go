@@ -0,0 +1,61 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package pull_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	infra "github.com/cgi-fr/lino/internal/infra/pull"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileStoreAbsoluteDir(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "files")
+	store := infra.NewFileStore(dir)
+
+	path1, err := store.Store([]byte("hello"))
+	assert.Nil(t, err)
+	assert.False(t, filepath.IsAbs(path1))
+	assert.Equal(t, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", filepath.Base(path1))
+
+	path2, err := store.Store([]byte("hello"))
+	assert.Nil(t, err)
+	assert.Equal(t, path1, path2)
+
+	content, err := os.ReadFile(path1)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("hello"), content)
+
+	entries, err := os.ReadDir(dir)
+	assert.Nil(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestFileStoreRelativeDir(t *testing.T) {
+	dir, err := os.MkdirTemp(".", "files-")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir) //nolint:errcheck
+
+	store := infra.NewFileStore(dir)
+
+	path, err := store.Store([]byte("hello"))
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.ToSlash(filepath.Join(dir, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824")), path)
+}
@@ -84,11 +84,13 @@ func (ds *HTTPDataSource) RowReader(source pull.Table, filter pull.Filter) (pull
 		Limit    uint     `json:"limit"`
 		Where    string   `json:"where"`
 		Distinct bool     `json:"distinct"`
+		OrderBy  []string `json:"orderBy,omitempty"`
 	}{
 		Values:   filter.Values,
 		Limit:    filter.Limit,
 		Where:    filter.Where,
 		Distinct: filter.Distinct,
+		OrderBy:  filter.OrderBy,
 	})
 	if err != nil {
 		return nil, err
@@ -0,0 +1,113 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package pull
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cgi-fr/lino/pkg/pull"
+	"github.com/schollz/progressbar/v3"
+)
+
+// Observer shows the pull progress on stderr.
+type Observer struct {
+	mutex   sync.Mutex
+	count   int
+	fetched map[pull.TableName]int
+	bar     *progressbar.ProgressBar
+}
+
+func NewObserver() *Observer {
+	return &Observer{
+		mutex:   sync.Mutex{},
+		count:   0,
+		fetched: map[pull.TableName]int{},
+		bar:     nil,
+	}
+}
+
+// Started creates the progress bar, the completion percentage is shown if total is known.
+func (o *Observer) Started(total int) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	//nolint:gomnd
+	o.bar = progressbar.NewOptions(total,
+		progressbar.OptionSetDescription("Pulling ... "),
+		progressbar.OptionSetItsString("entity"),
+		progressbar.OptionSetWriter(os.Stderr),
+		progressbar.OptionShowIts(),
+		progressbar.OptionShowCount(),
+		progressbar.OptionSpinnerType(11),
+		progressbar.OptionThrottle(time.Millisecond*10),
+		progressbar.OptionOnCompletion(func() { fmt.Fprintln(os.Stderr) }),
+	)
+}
+
+func (o *Observer) Fetched(table pull.TableName) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	o.fetched[table]++
+}
+
+func (o *Observer) Exported() {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	_ = o.bar.Add(1)
+
+	o.count++
+
+	o.bar.Describe(fmt.Sprintf("Pulled %d entities (%s)", o.count, o.rowsPerTable()))
+}
+
+func (o *Observer) Close() {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.bar != nil {
+		_ = o.bar.Close()
+	}
+}
+
+// rowsPerTable returns the number of rows fetched from each table, sorted by table name.
+func (o *Observer) rowsPerTable() string {
+	tables := make([]string, 0, len(o.fetched))
+	for table := range o.fetched {
+		tables = append(tables, string(table))
+	}
+
+	sort.Strings(tables)
+
+	sb := strings.Builder{}
+	for i, table := range tables {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+
+		fmt.Fprintf(&sb, "%s: %d", table, o.fetched[pull.TableName(table)])
+	}
+
+	return sb.String()
+}
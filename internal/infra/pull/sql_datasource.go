@@ -128,6 +128,28 @@ func (ds *SQLDataSource) RowReader(source pull.Table, filter pull.Filter) (pull.
 	return &SQLDataIterator{rows, nil, nil}, nil
 }
 
+// Explain return the parameterized SELECT query without executing it
+func (ds *SQLDataSource) Explain(source pull.Table, filter pull.Filter) string {
+	_, sql := ds.GetSelectSQLAndValues(source, filter)
+	return sql
+}
+
+// Count return the number of rows of source matching filter, the limit and order of the filter are ignored
+func (ds *SQLDataSource) Count(source pull.Table, filter pull.Filter) (int, error) {
+	values, sql := ds.GetSelectSQLAndValues(source, pull.Filter{Values: filter.Values, Where: filter.Where, Distinct: filter.Distinct})
+
+	sql = "SELECT COUNT(*) FROM (" + sql + ") lino_count"
+
+	commonsql.LogSQLQuery(sql, values, ds.dialect)
+
+	var count int
+	if err := ds.dbx.QueryRowx(sql, values...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("%w", err)
+	}
+
+	return count, nil
+}
+
 func (ds *SQLDataSource) GetSelectSQLAndValues(source pull.Table, filter pull.Filter) ([]interface{}, string) {
 	sqlColumns := []commonsql.ColumnExportDefinition{}
 
@@ -144,19 +166,20 @@ func (ds *SQLDataSource) GetSelectSQLAndValues(source pull.Table, filter pull.Fi
 		sqlWhere = " 1=1 "
 	}
 
-	// If schema name is inclued in table name
+	// If schema name is inclued in table name, it only applies to this table
+	schema := ds.schema
 	if strings.Contains(string(source.Name), ".") {
 		parts := strings.Split(string(source.Name), ".")
-		ds.schema = parts[0]
+		schema = parts[0]
 		source.Name = pull.TableName(parts[1])
 	}
 
 	// Assemble the builders in order using the existing method Select/SelectLimit
 	var sql string
 	if filter.Limit > 0 {
-		sql = ds.dialect.SelectLimit(string(source.Name), ds.schema, sqlWhere, filter.Distinct, filter.Limit, sqlColumns...)
+		sql = ds.dialect.SelectLimit(string(source.Name), schema, sqlWhere, filter.Distinct, filter.OrderBy, filter.Limit, sqlColumns...)
 	} else {
-		sql = ds.dialect.Select(string(source.Name), ds.schema, sqlWhere, filter.Distinct, sqlColumns...)
+		sql = ds.dialect.Select(string(source.Name), schema, sqlWhere, filter.Distinct, filter.OrderBy, sqlColumns...)
 	}
 	return values, sql
 }
@@ -0,0 +1,239 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/cgi-fr/lino/pkg/push"
+	"github.com/rs/zerolog/log"
+)
+
+// maxBulkParameters limits the number of parameters in a single multi-row INSERT statement
+const maxBulkParameters = 32000
+
+// bulkSavepoint is the savepoint set before each bulk load
+const bulkSavepoint = "lino_bulk"
+
+// SQLBulkDialect is implemented by dialects with a native mechanism to load many rows at once
+type SQLBulkDialect interface {
+	// BulkInsert loads rows in table inside the transaction, each row contains one value per header
+	BulkInsert(tx *sql.Tx, tableName string, headers []ValueDescriptor, primaryKeys []string, rows [][]interface{}) error
+	// SavepointStatement returns the statement setting the savepoint name in the transaction
+	SavepointStatement(name string) string
+	// RollbackToSavepointStatement returns the statement cancelling the statements executed since the savepoint name
+	RollbackToSavepointStatement(name string) string
+}
+
+// SetBulk activates the bulk load, rows are buffered by table and flushed at each commit
+func (dd *SQLDataDestination) SetBulk(bulk bool) {
+	dd.bulk = bulk
+}
+
+// flush loads buffered rows of all tables, parents first
+func (dd *SQLDataDestination) flush() *push.Error {
+	if !dd.bulk {
+		return nil
+	}
+
+	flushed := map[string]bool{}
+
+	for _, name := range dd.tableOrder {
+		if rw, ok := dd.rowWriter[name]; ok {
+			if err := rw.flush(); err != nil {
+				return err
+			}
+			flushed[name] = true
+		}
+	}
+
+	for name, rw := range dd.rowWriter {
+		if !flushed[name] {
+			if err := rw.flush(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// buffer adds the row to the rows to load at the next flush
+func (rw *SQLRowWriter) buffer(row push.Row, where push.Row) *push.Error {
+	if rw.headers == nil {
+		rw.headers, _ = rw.computeStatementInfos(row, where)
+		rw.sqlLogger = rw.dd.sqlLogger.OpenWriter(rw.table, "BULK INSERT INTO "+rw.tableName()+" "+rw.headers.String())
+	}
+
+	importedRow, err := rw.table.Import(row)
+	if err != nil {
+		return err
+	}
+
+	values := make([]interface{}, 0, len(rw.headers))
+	for _, h := range rw.headers {
+		values = append(values, rw.dd.dialect.ConvertValue(importedRow.GetOrNil(h.name), h))
+	}
+
+	rw.sqlLogger.Write(values)
+
+	rw.bulkRows = append(rw.bulkRows, values)
+
+	return nil
+}
+
+// flush loads buffered rows with the native bulk mechanism of the dialect, if a row has the primary key of an
+// existing row the load is cancelled and the rows are inserted one by one, skipping existing rows
+func (rw *SQLRowWriter) flush() *push.Error {
+	if len(rw.bulkRows) == 0 {
+		return nil
+	}
+
+	bulkDialect, ok := rw.dd.dialect.(SQLBulkDialect)
+	if !ok {
+		return &push.Error{Description: "bulk load is not supported by this database"}
+	}
+
+	log.Debug().Str("table", rw.table.Name()).Int("rows", len(rw.bulkRows)).Stringer("headers", rw.headers).Msg("bulk load")
+
+	if _, err := rw.dd.tx.Exec(bulkDialect.SavepointStatement(bulkSavepoint)); err != nil {
+		return rw.dd.writeError(err)
+	}
+
+	if err := bulkDialect.BulkInsert(rw.dd.tx, rw.tableName(), rw.headers, rw.table.PrimaryKey(), rw.bulkRows); err != nil {
+		if !rw.dd.dialect.IsDuplicateError(err) {
+			return rw.dd.writeError(err)
+		}
+
+		log.Debug().Str("table", rw.table.Name()).AnErr("error", err).Msg("duplicate key during bulk load, insert rows one by one")
+
+		if _, err := rw.dd.tx.Exec(bulkDialect.RollbackToSavepointStatement(bulkSavepoint)); err != nil {
+			return rw.dd.writeError(err)
+		}
+
+		if err := rw.insertBuffered(); err != nil {
+			return err
+		}
+	}
+
+	rw.bulkRows = rw.bulkRows[:0]
+	rw.headers = nil
+
+	return nil
+}
+
+// insertBuffered inserts buffered rows one by one, rows with the primary key of an existing row are skipped
+func (rw *SQLRowWriter) insertBuffered() *push.Error {
+	statement, headers := rw.dd.dialect.InsertStatement(rw.tableName(), rw.headers, rw.table.PrimaryKey())
+
+	positions := make(map[string]int, len(rw.headers))
+	for i, h := range rw.headers {
+		positions[h.name] = i
+	}
+
+	stmt, err := rw.dd.tx.Prepare(statement)
+	if err != nil {
+		return rw.dd.writeError(err)
+	}
+	defer stmt.Close() //nolint:errcheck
+
+	values := make([]interface{}, len(headers))
+	for _, row := range rw.bulkRows {
+		for i, h := range headers {
+			values[i] = row[positions[h.name]]
+		}
+
+		if _, err := stmt.Exec(values...); err != nil {
+			if !rw.dd.dialect.IsDuplicateError(err) {
+				return rw.dd.writeError(err)
+			}
+			log.Trace().Msg(fmt.Sprintf("duplicate key %v (%s) for %s", row, rw.table.PrimaryKey(), rw.table.Name()))
+		}
+	}
+
+	return nil
+}
+
+// copyIn executes a prepared bulk copy statement once per row, then once without values to end the copy
+func copyIn(tx *sql.Tx, statement string, rows [][]interface{}) error {
+	stmt, err := tx.Prepare(statement)
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		if _, err := stmt.Exec(row...); err != nil {
+			stmt.Close() //nolint:errcheck,gosec
+			return err
+		}
+	}
+
+	if _, err := stmt.Exec(); err != nil {
+		stmt.Close() //nolint:errcheck,gosec
+		return err
+	}
+
+	return stmt.Close()
+}
+
+// multiRowInsert turns a single row insert statement into INSERT ... VALUES (...), (...) statements
+func multiRowInsert(tx *sql.Tx, d SQLDialect, insertStatement string, rows [][]interface{}) error {
+	idx := strings.LastIndex(insertStatement, " VALUES (")
+	if idx < 0 {
+		return fmt.Errorf("unable to build multi-row insert from statement %s", insertStatement)
+	}
+
+	prefix := insertStatement[:idx] + " VALUES "
+
+	columnCount := len(rows[0])
+	batchSize := len(rows)
+	if columnCount > 0 && columnCount*batchSize > maxBulkParameters {
+		batchSize = maxBulkParameters / columnCount
+	}
+
+	for start := 0; start < len(rows); start += batchSize {
+		end := min(start+batchSize, len(rows))
+
+		sb := &strings.Builder{}
+		sb.WriteString(prefix)
+
+		values := make([]interface{}, 0, (end-start)*columnCount)
+		for i, row := range rows[start:end] {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString("(")
+			for j := range row {
+				if j > 0 {
+					sb.WriteString(", ")
+				}
+				sb.WriteString(d.Placeholder(len(values) + j + 1))
+			}
+			sb.WriteString(")")
+			values = append(values, row...)
+		}
+
+		if _, err := tx.Exec(sb.String(), values...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
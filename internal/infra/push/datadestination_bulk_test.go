@@ -0,0 +1,149 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cgi-fr/lino/internal/infra/commonsql"
+	"github.com/cgi-fr/lino/pkg/push"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulkInsertMariaDB(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.Nil(t, err)
+	defer db.Close() //nolint:errcheck
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT IGNORE INTO customer(`id`,`name`) VALUES (?, ?), (?, ?)").
+		WithArgs(1, "John", 2, "Jane").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	tx, err := db.Begin()
+	assert.Nil(t, err)
+
+	d := MariadbDialect{innerDialect: commonsql.MariadbDialect{}}
+	headers := []ValueDescriptor{{name: "id"}, {name: "name"}}
+	rows := [][]interface{}{{1, "John"}, {2, "Jane"}}
+
+	assert.Nil(t, d.BulkInsert(tx, "customer", headers, []string{"id"}, rows))
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestBulkInsertPostgres(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.Nil(t, err)
+	defer db.Close() //nolint:errcheck
+
+	mock.ExpectBegin()
+	prepare := mock.ExpectPrepare(`COPY "public"."customer" ("id", "name") FROM STDIN`)
+	prepare.ExpectExec().WithArgs(1, "John").WillReturnResult(sqlmock.NewResult(0, 0))
+	prepare.ExpectExec().WithArgs(2, "Jane").WillReturnResult(sqlmock.NewResult(0, 0))
+	prepare.ExpectExec().WithoutArgs().WillReturnResult(sqlmock.NewResult(0, 2))
+	prepare.WillBeClosed()
+
+	tx, err := db.Begin()
+	assert.Nil(t, err)
+
+	d := PostgresDialect{innerDialect: commonsql.PostgresDialect{}}
+	headers := []ValueDescriptor{{name: "id"}, {name: "name"}}
+	rows := [][]interface{}{{1, "John"}, {2, "Jane"}}
+
+	assert.Nil(t, d.BulkInsert(tx, "public.customer", headers, []string{"id"}, rows))
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+// arrayConverter lets the arrays bound by the Oracle dialect reach the mock unchanged
+type arrayConverter struct{}
+
+func (arrayConverter) ConvertValue(v interface{}) (driver.Value, error) {
+	return v, nil
+}
+
+func TestBulkInsertOracle(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual), sqlmock.ValueConverterOption(arrayConverter{}))
+	assert.Nil(t, err)
+	defer db.Close() //nolint:errcheck
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO "customer"("id","name","score") VALUES (:v1, :v2, :v3)`).
+		WithArgs(
+			[]sql.NullInt64{{Int64: 1, Valid: true}, {Int64: 2, Valid: true}},
+			[]sql.NullString{{String: "John", Valid: true}, {}},
+			[]sql.NullFloat64{{Float64: 1, Valid: true}, {Float64: 2.5, Valid: true}},
+		).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	tx, err := db.Begin()
+	assert.Nil(t, err)
+
+	d := OracleDialect{innerDialect: commonsql.OracleDialect{}}
+	headers := []ValueDescriptor{{name: "id"}, {name: "name"}, {name: "score"}}
+	rows := [][]interface{}{{int64(1), "John", int64(1)}, {int64(2), nil, 2.5}}
+
+	assert.Nil(t, d.BulkInsert(tx, "customer", headers, []string{"id"}, rows))
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestBulkDuplicateKey(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.Nil(t, err)
+	defer db.Close() //nolint:errcheck
+
+	mock.ExpectBegin()
+	mock.ExpectExec("SAVEPOINT lino_bulk").WillReturnResult(sqlmock.NewResult(0, 0))
+	prepare := mock.ExpectPrepare(`COPY "customer" ("id") FROM STDIN`)
+	prepare.ExpectExec().WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+	prepare.ExpectExec().WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 0))
+	prepare.ExpectExec().WithoutArgs().WillReturnError(&pq.Error{Code: "23505"})
+	// the copy is cancelled and rows are inserted one by one, the existing row is skipped
+	mock.ExpectExec("ROLLBACK TO SAVEPOINT lino_bulk").WillReturnResult(sqlmock.NewResult(0, 0))
+	insert := mock.ExpectPrepare(`INSERT INTO "customer"("id") VALUES ($1) ON CONFLICT (id) DO NOTHING`)
+	insert.ExpectExec().WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+	insert.ExpectExec().WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 1))
+	insert.WillBeClosed()
+
+	tx, err := db.Begin()
+	assert.Nil(t, err)
+
+	dd := NewSQLDataDestination("", "", PostgresDialect{innerDialect: commonsql.PostgresDialect{}})
+	dd.tx = tx
+	dd.mode = push.Insert
+	dd.SetBulk(true)
+
+	rw := NewSQLRowWriter(push.NewTable("customer", []string{"id"}, nil), dd)
+	dd.rowWriter["customer"] = rw
+
+	assert.Nil(t, rw.Write(push.Row{"id": 1}, nil))
+	assert.Nil(t, rw.Write(push.Row{"id": 2}, nil))
+	assert.Nil(t, dd.flush())
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
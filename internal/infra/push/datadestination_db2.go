@@ -21,7 +21,10 @@
 package push
 
 import (
+	"database/sql"
 	"fmt"
+	"io"
+	"regexp"
 	"strings"
 	"time"
 
@@ -45,6 +48,20 @@ func (e *Db2DataDestinationFactory) New(url string, schema string) push.DataDest
 	return NewSQLDataDestination(url, schema, Db2Dialect{innerDialect: commonsql.Db2Dialect{}})
 }
 
+// NewScript return a Db2 script writer
+func (e *Db2DataDestinationFactory) NewScript(script io.Writer, schema string) (push.DataDestination, *push.Error) {
+	dd, err := NewScriptDataDestination(script, schema, Db2Dialect{innerDialect: commonsql.Db2Dialect{}})
+	if err != nil {
+		return nil, err
+	}
+	return dd, nil
+}
+
+// NewDiff return a Db2 datadestination comparing pushed rows with the database
+func (e *Db2DataDestinationFactory) NewDiff(url string, schema string, report push.RowWriter) push.DataDestination {
+	return NewSQLDiffDataDestination(url, schema, Db2Dialect{innerDialect: commonsql.Db2Dialect{}}, report)
+}
+
 // Db2Dialect inject oracle variations
 type Db2Dialect struct {
 	innerDialect commonsql.Dialect
@@ -99,8 +116,76 @@ func (d Db2Dialect) InsertStatement(tableName string, selectValues []ValueDescri
 	return sql.String(), selectValues
 }
 
-func (d Db2Dialect) UpsertStatement(tableName string, selectValues []ValueDescriptor, whereValues []ValueDescriptor, primaryKeys []string) (statement string, headers []ValueDescriptor, err *push.Error) {
-	return "", nil, &push.Error{Description: "upsert not implemented for db2"}
+// InsertReturningStatement reads back the generated key by selecting from the FINAL TABLE of the insert
+func (d Db2Dialect) InsertReturningStatement(tableName string, selectValues []ValueDescriptor, key string) (statement string, headers []ValueDescriptor, mode GeneratedKeyMode) {
+	statement, headers = d.InsertStatement(tableName, selectValues, nil)
+	return "SELECT " + d.Quote(key) + " FROM FINAL TABLE (" + statement + ")", headers, GeneratedKeyQuery
+}
+
+// UpsertStatement generates a MERGE statement applying policy
+func (d Db2Dialect) UpsertStatement(tableName string, selectValues []ValueDescriptor, whereValues []ValueDescriptor, primaryKeys []string, policy push.ConflictPolicy, version string) (statement string, headers []ValueDescriptor, err *push.Error) {
+	if len(primaryKeys) == 0 {
+		return "", nil, &push.Error{Description: fmt.Sprintf("can't upsert table [%s] because no primary key is defined", tableName)}
+	}
+
+	updates, err := conflictUpdates(tableName, selectValues, primaryKeys, policy, version)
+	if err != nil {
+		return "", nil, err
+	}
+
+	protectedColumns := []string{}
+	for _, c := range selectValues {
+		protectedColumns = append(protectedColumns, d.Quote(c.name))
+	}
+
+	sql := &strings.Builder{}
+	sql.WriteString("MERGE INTO ")
+	sql.WriteString(tableName)
+	sql.WriteString(" AS target USING (VALUES (")
+	for i := 1; i <= len(selectValues); i++ {
+		sql.WriteString(d.Placeholder(i))
+		if i < len(selectValues) {
+			sql.WriteString(", ")
+		}
+	}
+	sql.WriteString(")) AS source (")
+	sql.WriteString(strings.Join(protectedColumns, ","))
+	sql.WriteString(") ON (")
+	for i, pk := range primaryKeys {
+		if i > 0 {
+			sql.WriteString(" AND ")
+		}
+		sql.WriteString("target." + d.Quote(pk) + " = source." + d.Quote(pk))
+	}
+	sql.WriteString(")")
+
+	if len(updates) > 0 {
+		sql.WriteString(" WHEN MATCHED")
+		if policy == push.ConflictKeepNewer {
+			sql.WriteString(" AND source." + d.Quote(version) + " > target." + d.Quote(version))
+		}
+		sql.WriteString(" THEN UPDATE SET ")
+		for i, column := range updates {
+			if i > 0 {
+				sql.WriteString(", ")
+			}
+			quoted := d.Quote(column.name)
+			sql.WriteString(quoted + " = " + conflictValue(policy, "target."+quoted, "source."+quoted))
+		}
+	}
+
+	sql.WriteString(" WHEN NOT MATCHED THEN INSERT (")
+	sql.WriteString(strings.Join(protectedColumns, ","))
+	sql.WriteString(") VALUES (")
+	for i, c := range selectValues {
+		if i > 0 {
+			sql.WriteString(", ")
+		}
+		sql.WriteString("source." + d.Quote(c.name))
+	}
+	sql.WriteString(")")
+
+	return sql.String(), selectValues, nil
 }
 
 // UpdateStatement
@@ -154,12 +239,59 @@ func (d Db2Dialect) UpdateStatement(tableName string, selectValues []ValueDescri
 	return sql.String(), headers, nil
 }
 
+// BulkInsert loads rows with multi-row INSERT statements
+func (d Db2Dialect) BulkInsert(tx *sql.Tx, tableName string, headers []ValueDescriptor, primaryKeys []string, rows [][]interface{}) error {
+	statement, _ := d.InsertStatement(tableName, headers, primaryKeys)
+
+	return multiRowInsert(tx, d, statement, rows)
+}
+
+// SavepointStatement marks the point to roll back to when a bulk load fails
+func (d Db2Dialect) SavepointStatement(name string) string {
+	return "SAVEPOINT " + name + " ON ROLLBACK RETAIN CURSORS"
+}
+
+// RollbackToSavepointStatement cancels the statements executed since the savepoint
+func (d Db2Dialect) RollbackToSavepointStatement(name string) string {
+	return "ROLLBACK TO SAVEPOINT " + name
+}
+
 // IsDuplicateError check if error is a duplicate error
 func (d Db2Dialect) IsDuplicateError(err error) bool {
 	// -803
 	return strings.Contains(err.Error(), "-803")
 }
 
+// IsTransientError check if error is a deadlock or timeout rollback (-911, -913) or a communication error (-30081)
+func (d Db2Dialect) IsTransientError(err error) bool {
+	for _, code := range []string{"-911", "-913", "-30081"} {
+		if strings.Contains(err.Error(), code) {
+			return true
+		}
+	}
+	return isConnectionError(err)
+}
+
+// db2State matches the SQLSTATE in DB2 messages
+var db2State = regexp.MustCompile(`SQLSTATE=(\w{5})`)
+
+// ErrorDetails returns the SQLSTATE found in a DB2 message, the constraint is not available
+func (d Db2Dialect) ErrorDetails(err error) (string, string) {
+	return submatch(db2State, err.Error()), ""
+}
+
+// db2Literals renders literal values in DB2 scripts
+var db2Literals = literalFormat{
+	bytes:     func(hexa string) string { return "BX'" + hexa + "'" },
+	boolean:   numericBoolean,
+	timestamp: func(t time.Time) string { return "TIMESTAMP '" + t.Format("2006-01-02 15:04:05.999999") + "'" },
+}
+
+// Literal renders value as a SQL literal
+func (d Db2Dialect) Literal(value push.Value) string {
+	return db2Literals.literal(value)
+}
+
 // ConvertValue before load
 func (d Db2Dialect) ConvertValue(from push.Value, descriptor ValueDescriptor) push.Value {
 	// FIXME: Workaround to parse time from json
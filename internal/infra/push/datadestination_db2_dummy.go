@@ -22,6 +22,7 @@
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/cgi-fr/lino/pkg/push"
 )
@@ -39,6 +40,20 @@ func (e *Db2DataDestinationFactory) New(url string, schema string) push.DataDest
 	return NewSQLDataDestination(url, schema, Db2Dialect{})
 }
 
+// NewScript return a Db2 script writer
+func (e *Db2DataDestinationFactory) NewScript(script io.Writer, schema string) (push.DataDestination, *push.Error) {
+	dd, err := NewScriptDataDestination(script, schema, Db2Dialect{})
+	if err != nil {
+		return nil, err
+	}
+	return dd, nil
+}
+
+// NewDiff return a Db2 datadestination comparing pushed rows with the database
+func (e *Db2DataDestinationFactory) NewDiff(url string, schema string, report push.RowWriter) push.DataDestination {
+	return NewSQLDiffDataDestination(url, schema, Db2Dialect{}, report)
+}
+
 // Db2Dialect inject oracle variations
 type Db2Dialect struct{}
 
@@ -73,7 +88,7 @@ func (d Db2Dialect) InsertStatement(tableName string, selectValues []ValueDescri
 }
 
 // UpsertStatement
-func (d Db2Dialect) UpsertStatement(tableName string, selectValues []ValueDescriptor, whereValues []ValueDescriptor, primaryKeys []string) (statement string, headers []ValueDescriptor, err *push.Error) {
+func (d Db2Dialect) UpsertStatement(tableName string, selectValues []ValueDescriptor, whereValues []ValueDescriptor, primaryKeys []string, policy push.ConflictPolicy, version string) (statement string, headers []ValueDescriptor, err *push.Error) {
 	panic(fmt.Errorf("not implemented"))
 }
 
@@ -87,6 +102,16 @@ func (d Db2Dialect) IsDuplicateError(err error) bool {
 	panic(fmt.Errorf("not implemented"))
 }
 
+// IsTransientError check if error can succeed when retried
+func (d Db2Dialect) IsTransientError(err error) bool {
+	panic(fmt.Errorf("not implemented"))
+}
+
+// ErrorDetails returns the error code and the constraint of err
+func (d Db2Dialect) ErrorDetails(err error) (string, string) {
+	panic(fmt.Errorf("not implemented"))
+}
+
 // ConvertValue before load
 func (d Db2Dialect) ConvertValue(from push.Value, descriptor ValueDescriptor) push.Value {
 	panic(fmt.Errorf("not implemented"))
@@ -0,0 +1,300 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/cgi-fr/lino/pkg/push"
+	"github.com/xo/dburl"
+)
+
+// actions reported by the diff of a push
+const (
+	diffInsert    = "insert"
+	diffUpdate    = "update"
+	diffUnchanged = "unchanged"
+	diffDelete    = "delete"
+	diffTruncate  = "truncate"
+	diffUnknown   = "unknown"
+	diffConflict  = "conflict"
+)
+
+// SQLDiffDataDestination compares the pushed rows with the rows of the database, without writing anything.
+type SQLDiffDataDestination struct {
+	url      string
+	schema   string
+	dialect  SQLDialect
+	report   push.RowWriter
+	db       *sql.DB
+	mode     push.Mode
+	conflict push.ConflictPolicy
+	tables   []string
+	summary  map[string]map[string]int
+}
+
+// NewSQLDiffDataDestination creates a new datadestination reporting the changes of each pushed row in report.
+func NewSQLDiffDataDestination(url string, schema string, dialect SQLDialect, report push.RowWriter) *SQLDiffDataDestination {
+	return &SQLDiffDataDestination{
+		url:     url,
+		schema:  schema,
+		dialect: dialect,
+		report:  report,
+		summary: map[string]map[string]int{},
+	}
+}
+
+// SetConflictPolicy sets the policy used to compare existing rows in insert and upsert modes
+func (dd *SQLDiffDataDestination) SetConflictPolicy(policy push.ConflictPolicy) {
+	dd.conflict = policy
+}
+
+// SafeUrl return the URL without user and password
+func (dd *SQLDiffDataDestination) SafeUrl() string {
+	return NewSQLDataDestination(dd.url, dd.schema, dd.dialect).SafeUrl()
+}
+
+// OpenSQLLogger is not available, no statement is executed
+func (dd *SQLDiffDataDestination) OpenSQLLogger(folderPath string) error {
+	return fmt.Errorf("SQL logger is not available when comparing rows")
+}
+
+// Open the connection used to read the rows of the database
+func (dd *SQLDiffDataDestination) Open(plan push.Plan, mode push.Mode, disableConstraints bool, whereClause string) *push.Error {
+	dd.mode = mode
+
+	db, err := dburl.Open(dd.url)
+	if err != nil {
+		return &push.Error{Description: err.Error()}
+	}
+
+	if err := db.Ping(); err != nil {
+		return &push.Error{Description: err.Error()}
+	}
+
+	dd.db = db
+
+	for _, table := range plan.Tables() {
+		dd.tables = append(dd.tables, table.Name())
+		dd.summary[table.Name()] = map[string]int{}
+
+		if mode == push.Truncate {
+			if err := dd.report.Write(push.Row{"table": table.Name(), "action": diffTruncate}, nil); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// RowWriter return the diff writer of the table
+func (dd *SQLDiffDataDestination) RowWriter(table push.Table) (push.RowWriter, *push.Error) {
+	return &SQLDiffRowWriter{table: table, dd: dd}, nil
+}
+
+// Commit does nothing, no change is made to the database
+func (dd *SQLDiffDataDestination) Commit() *push.Error {
+	return nil
+}
+
+// Close writes the summary of each table and close the connection
+func (dd *SQLDiffDataDestination) Close() *push.Error {
+	for _, table := range dd.tables {
+		actions := []string{diffInsert, diffUpdate, diffUnchanged, diffDelete, diffUnknown}
+		if dd.conflict == push.ConflictError {
+			actions = append(actions, diffConflict)
+		}
+
+		summary := push.Row{}
+		for _, action := range actions {
+			summary[action] = dd.summary[table][action]
+		}
+
+		if err := dd.report.Write(push.Row{"table": table, "summary": summary}, nil); err != nil {
+			return err
+		}
+	}
+
+	if dd.db != nil {
+		if err := dd.db.Close(); err != nil {
+			return &push.Error{Description: err.Error()}
+		}
+	}
+
+	return nil
+}
+
+// SQLDiffRowWriter reports the change of each row of a table.
+type SQLDiffRowWriter struct {
+	table push.Table
+	dd    *SQLDiffDataDestination
+}
+
+// Write reports the change that would be made by pushing row
+func (rw *SQLDiffRowWriter) Write(row push.Row, where push.Row) *push.Error {
+	key := push.Row{}
+	for _, pk := range rw.table.PrimaryKey() {
+		if value, exists := where[pk]; exists {
+			key[pk] = value
+		} else {
+			key[pk] = row[pk]
+		}
+	}
+
+	action, changes, err := rw.diff(row, key)
+	if err != nil {
+		return err
+	}
+
+	rw.dd.summary[rw.table.Name()][action]++
+
+	report := push.Row{"table": rw.table.Name(), "action": action, "key": key}
+	if len(changes) > 0 {
+		report["changes"] = changes
+	}
+
+	return rw.dd.report.Write(report, nil)
+}
+
+func (rw *SQLDiffRowWriter) diff(row push.Row, key push.Row) (string, push.Row, *push.Error) {
+	if rw.dd.mode == push.Truncate {
+		return diffInsert, nil, nil
+	}
+
+	if len(key) == 0 {
+		return diffUnknown, nil, nil
+	}
+
+	columns := []string{}
+	for column := range row {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+
+	current, err := rw.read(columns, key)
+	if err != nil {
+		return "", nil, err
+	}
+
+	switch {
+	case current == nil && (rw.dd.mode == push.Insert || rw.dd.mode == push.Upsert):
+		return diffInsert, nil, nil
+	case current == nil:
+		return diffUnchanged, nil, nil
+	case rw.dd.mode == push.Delete:
+		return diffDelete, nil, nil
+	}
+
+	policy := push.ConflictOverwrite
+	if rw.dd.mode == push.Insert || rw.dd.mode == push.Upsert {
+		policy = rw.dd.conflict.Resolve(rw.dd.mode)
+	}
+
+	switch policy {
+	case push.ConflictSkip:
+		// existing rows are ignored
+		return diffUnchanged, nil, nil
+	case push.ConflictError:
+		return diffConflict, nil, nil
+	case push.ConflictKeepNewer:
+		version := rw.table.Version()
+		if !isNewer(current[version], row[version]) {
+			return diffUnchanged, nil, nil
+		}
+	}
+
+	changes := push.Row{}
+	for _, column := range columns {
+		if policy == push.ConflictOverwriteNonNull && row[column] == nil {
+			continue
+		}
+		if !sameValue(current[column], row[column]) {
+			changes[column] = push.Row{"old": current[column], "new": row[column]}
+		}
+	}
+
+	if len(changes) == 0 {
+		return diffUnchanged, nil, nil
+	}
+	return diffUpdate, changes, nil
+}
+
+// read the columns of the row identified by key, returns nil if the row doesn't exist
+func (rw *SQLDiffRowWriter) read(columns []string, key push.Row) (push.Row, *push.Error) {
+	importedKey, err := rw.table.Import(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(columns) == 0 {
+		columns = rw.table.PrimaryKey()
+	}
+
+	values := []interface{}{}
+	for _, pk := range rw.table.PrimaryKey() {
+		descriptor := ValueDescriptor{pk, false, rw.table.GetColumn(pk)}
+		values = append(values, rw.dd.dialect.ConvertValue(importedKey.GetOrNil(pk), descriptor))
+	}
+
+	rows, err := readRows(rw.dd.db, rw.dd.dialect, qualifiedTableName(rw.dd.schema, rw.table), columns, rw.table.PrimaryKey(), values)
+	if err != nil || len(rows) == 0 {
+		return nil, err
+	}
+
+	return rows[0], nil
+}
+
+// isNewer returns true if the version value read in input is greater than the version read in the database
+func isNewer(current push.Value, value push.Value) bool {
+	if current == nil || value == nil {
+		return false
+	}
+
+	if t, ok := current.(time.Time); ok {
+		parsed, err := time.Parse(time.RFC3339Nano, fmt.Sprint(value))
+		return err == nil && parsed.After(t)
+	}
+
+	currentNumber, err1 := strconv.ParseFloat(fmt.Sprint(current), 64)
+	number, err2 := strconv.ParseFloat(fmt.Sprint(value), 64)
+	if err1 == nil && err2 == nil {
+		return number > currentNumber
+	}
+
+	return fmt.Sprint(value) > fmt.Sprint(current)
+}
+
+// sameValue compares a value read in the database with a value read in input by their textual representation
+func sameValue(current push.Value, value push.Value) bool {
+	if current == nil || value == nil {
+		return current == nil && value == nil
+	}
+
+	if t, ok := current.(time.Time); ok {
+		if parsed, err := time.Parse(time.RFC3339Nano, fmt.Sprint(value)); err == nil {
+			return t.Equal(parsed)
+		}
+	}
+
+	return fmt.Sprint(current) == fmt.Sprint(value)
+}
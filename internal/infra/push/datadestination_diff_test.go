@@ -0,0 +1,73 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cgi-fr/lino/internal/infra/commonsql"
+	"github.com/cgi-fr/lino/pkg/push"
+	"github.com/stretchr/testify/assert"
+)
+
+type memoryReport struct {
+	rows []push.Row
+}
+
+func (r *memoryReport) Write(row push.Row, where push.Row) *push.Error {
+	r.rows = append(r.rows, row)
+	return nil
+}
+
+func TestDiffUpsert(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.Nil(t, err)
+
+	query := `SELECT "age", "id", "name" FROM "public"."customer" WHERE "id"=$1`
+	mock.ExpectQuery(query).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"age", "id", "name"}).AddRow(30, 1, []byte("John")))
+	mock.ExpectQuery(query).WithArgs(2).WillReturnRows(sqlmock.NewRows([]string{"age", "id", "name"}).AddRow(40, 2, []byte("Jane")))
+	mock.ExpectQuery(query).WithArgs(3).WillReturnRows(sqlmock.NewRows([]string{"age", "id", "name"}))
+	mock.ExpectClose()
+
+	report := &memoryReport{}
+	dd := NewSQLDiffDataDestination("", "public", PostgresDialect{innerDialect: commonsql.PostgresDialect{}}, report)
+	dd.db = db
+	dd.mode = push.Upsert
+
+	customer := push.NewTable("customer", []string{"id"}, nil)
+	dd.tables = []string{customer.Name()}
+	dd.summary[customer.Name()] = map[string]int{}
+
+	rw, perr := dd.RowWriter(customer)
+	assert.Nil(t, perr)
+	assert.Nil(t, rw.Write(push.Row{"id": 1, "name": "Johnny", "age": 30}, push.Row{}))
+	assert.Nil(t, rw.Write(push.Row{"id": 2, "name": "Jane", "age": 40}, push.Row{}))
+	assert.Nil(t, rw.Write(push.Row{"id": 3, "name": "Jim", "age": 50}, push.Row{}))
+	assert.Nil(t, dd.Close())
+	assert.Nil(t, mock.ExpectationsWereMet())
+
+	assert.Equal(t, []push.Row{
+		{"table": "customer", "action": "update", "key": push.Row{"id": 1}, "changes": push.Row{"name": push.Row{"old": "John", "new": "Johnny"}}},
+		{"table": "customer", "action": "unchanged", "key": push.Row{"id": 2}},
+		{"table": "customer", "action": "insert", "key": push.Row{"id": 3}},
+		{"table": "customer", "summary": push.Row{"insert": 1, "update": 1, "unchanged": 1, "delete": 0, "unknown": 0}},
+	}, report.rows)
+}
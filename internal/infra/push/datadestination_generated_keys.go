@@ -0,0 +1,123 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/cgi-fr/lino/pkg/push"
+	"github.com/rs/zerolog/log"
+)
+
+// GeneratedKeyMode tells how the value generated by the database is read back after an insert
+type GeneratedKeyMode int
+
+const (
+	// GeneratedKeyQuery the statement returns the generated key as a single row (RETURNING, OUTPUT, FINAL TABLE)
+	GeneratedKeyQuery GeneratedKeyMode = iota
+	// GeneratedKeyOutParameter the generated key is returned in an additional numeric output parameter (RETURNING INTO)
+	GeneratedKeyOutParameter
+	// GeneratedKeyLastInsertID the generated key is read from the result of the statement (LAST_INSERT_ID)
+	GeneratedKeyLastInsertID
+)
+
+// SQLGeneratedKeyDialect is implemented by dialects able to read back the key generated by the database on insert
+type SQLGeneratedKeyDialect interface {
+	// InsertReturningStatement generates an insert statement giving back the value generated for the column key
+	InsertReturningStatement(tableName string, selectValues []ValueDescriptor, key string) (statement string, headers []ValueDescriptor, mode GeneratedKeyMode)
+}
+
+func (rw *SQLRowWriter) createKeyStatement(row push.Row, key string) *push.Error {
+	if rw.keyStatement != nil {
+		return nil
+	}
+
+	dialect, ok := rw.dd.dialect.(SQLGeneratedKeyDialect)
+	if !ok {
+		return &push.Error{Description: fmt.Sprintf("reading back generated keys is not supported by the dialect of %s", rw.dd.SafeUrl())}
+	}
+
+	selectValues, _ := rw.computeStatementInfos(row, nil)
+
+	var prepareStmt string
+	prepareStmt, rw.keyHeaders, rw.keyMode = dialect.InsertReturningStatement(rw.tableName(), selectValues, key)
+
+	log.Debug().Stringer("headers", rw.keyHeaders).Msg(prepareStmt)
+
+	stmt, err := rw.dd.tx.Prepare(prepareStmt)
+	if err != nil {
+		return &push.Error{Description: err.Error()}
+	}
+	rw.keyStatement = stmt
+	if rw.sqlLogger == nil {
+		rw.sqlLogger = rw.dd.sqlLogger.OpenWriter(rw.table, prepareStmt)
+	}
+	return nil
+}
+
+// WriteReturningKey inserts row and returns the value generated by the database for the column key
+func (rw *SQLRowWriter) WriteReturningKey(row push.Row, key string) (push.Value, *push.Error) {
+	if rw.dd.bulk {
+		return nil, &push.Error{Description: "generated keys can't be read back in bulk mode"}
+	}
+
+	err1 := rw.createKeyStatement(row, key)
+	if err1 != nil {
+		return nil, err1
+	}
+
+	importedRow, err2 := rw.table.Import(row)
+	if err2 != nil {
+		return nil, err2
+	}
+
+	values := []interface{}{}
+	for _, h := range rw.keyHeaders {
+		values = append(values, rw.dd.dialect.ConvertValue(importedRow.GetOrNil(h.name), h))
+	}
+	log.Trace().Stringer("headers", rw.keyHeaders).Str("table", rw.table.Name()).Msg(fmt.Sprint(values))
+
+	rw.sqlLogger.Write(values)
+
+	var generated push.Value
+	var err3 error
+
+	switch rw.keyMode {
+	case GeneratedKeyOutParameter:
+		var id int64
+		_, err3 = rw.keyStatement.Exec(append(values, sql.Out{Dest: &id})...)
+		generated = id
+	case GeneratedKeyLastInsertID:
+		var result sql.Result
+		if result, err3 = rw.keyStatement.Exec(values...); err3 == nil {
+			generated, err3 = result.LastInsertId()
+		}
+	default:
+		err3 = rw.keyStatement.QueryRow(values...).Scan(&generated)
+	}
+
+	if err3 != nil {
+		log.Trace().AnErr("error", err3).Msg("push error")
+		return nil, rw.dd.writeError(err3)
+	}
+
+	log.Trace().Str("table", rw.table.Name()).Str("key", key).Msg(fmt.Sprintf("generated value %v", generated))
+
+	return generated, nil
+}
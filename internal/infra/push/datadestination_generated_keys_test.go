@@ -0,0 +1,76 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cgi-fr/lino/internal/infra/commonsql"
+	"github.com/cgi-fr/lino/pkg/push"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInsertReturningStatement(t *testing.T) {
+	t.Parallel()
+
+	selectValues := []ValueDescriptor{{name: "name"}}
+
+	tests := []struct {
+		dialect   SQLGeneratedKeyDialect
+		tableName string
+		statement string
+		mode      GeneratedKeyMode
+	}{
+		{PostgresDialect{innerDialect: commonsql.PostgresDialect{}}, "public.customer", `INSERT INTO "public"."customer"("name") VALUES ($1) RETURNING "id"`, GeneratedKeyQuery},
+		{SQLServerDialect{innerDialect: commonsql.SQLServerDialect{}}, "customer", `INSERT INTO [customer]([name]) OUTPUT INSERTED.[id] VALUES (@p1)`, GeneratedKeyQuery},
+		{MariadbDialect{innerDialect: commonsql.MariadbDialect{}}, "customer", "INSERT INTO customer(`name`) VALUES (?)", GeneratedKeyLastInsertID},
+		{OracleDialect{innerDialect: commonsql.OracleDialect{}}, "customer", `INSERT INTO "customer"("name") VALUES (:v1) RETURNING "id" INTO :v2`, GeneratedKeyOutParameter},
+	}
+
+	for _, test := range tests {
+		statement, headers, mode := test.dialect.InsertReturningStatement(test.tableName, selectValues, "id")
+		assert.Equal(t, test.statement, statement)
+		assert.Equal(t, selectValues, headers)
+		assert.Equal(t, test.mode, mode)
+	}
+}
+
+func TestWriteReturningKeyPostgres(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.Nil(t, err)
+	defer db.Close() //nolint:errcheck
+
+	mock.ExpectBegin()
+	prepare := mock.ExpectPrepare(`INSERT INTO "customer"("name") VALUES ($1) RETURNING "id"`)
+	prepare.ExpectQuery().WithArgs("John").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(42))
+
+	tx, err := db.Begin()
+	assert.Nil(t, err)
+
+	dd := NewSQLDataDestination("", "", PostgresDialect{innerDialect: commonsql.PostgresDialect{}})
+	dd.tx = tx
+	rw := NewSQLRowWriter(push.NewTable("customer", []string{"id"}, nil), dd)
+
+	generated, perr := rw.WriteReturningKey(push.Row{"name": "John"}, "id")
+	assert.Nil(t, perr)
+	assert.Equal(t, int64(42), generated)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
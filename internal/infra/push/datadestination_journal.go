@@ -0,0 +1,353 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cgi-fr/lino/pkg/push"
+	"github.com/rs/zerolog/log"
+	"github.com/xo/dburl"
+)
+
+// actions recorded in the journal
+const (
+	journalInsert   = "insert"
+	journalUpdate   = "update"
+	journalDelete   = "delete"
+	journalTruncate = "truncate"
+)
+
+// queryer is implemented by sql.DB and sql.Tx
+type queryer interface {
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// SetJournal records the prior state of the modified rows in journal, entries are written at each commit
+func (dd *SQLDataDestination) SetJournal(journal push.RowWriter) {
+	dd.journal = journal
+}
+
+// record adds an entry to the journal of the current transaction
+func (dd *SQLDataDestination) record(entry push.Row) {
+	if dd.journal != nil {
+		dd.journalEntries = append(dd.journalEntries, entry)
+	}
+}
+
+// writeJournal writes the entries of the committed transaction to the journal
+func (dd *SQLDataDestination) writeJournal() *push.Error {
+	if dd.journal == nil {
+		return nil
+	}
+
+	for _, entry := range dd.journalEntries {
+		if err := dd.journal.Write(entry, nil); err != nil {
+			return err
+		}
+	}
+	dd.journalEntries = nil
+
+	return nil
+}
+
+// snapshot writes all rows of tables to the journal before they are truncated, children tables first, the truncate
+// is not part of the transaction so entries are written immediately instead of at the first commit
+func (dd *SQLDataDestination) snapshot(tables []push.Table) *push.Error {
+	for i := len(tables) - 1; i >= 0; i-- {
+		table := tables[i]
+
+		err := scanRows(dd.tx, dd.dialect, qualifiedTableName(dd.schema, table), nil, nil, nil, func(row push.Row) *push.Error {
+			key := push.Row{}
+			for _, pk := range table.PrimaryKey() {
+				key[pk] = row[pk]
+			}
+			return dd.journal.Write(push.Row{"table": table.Name(), "action": journalTruncate, "key": key, "before": row}, nil)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// prior reads the state of the row before it is written, and returns the journal entry to record after the write
+func (rw *SQLRowWriter) prior(row push.Row, where push.Row) (push.Row, *push.Error) {
+	if len(rw.table.PrimaryKey()) == 0 {
+		return nil, &push.Error{Description: fmt.Sprintf("table %s has no primary key, the journal can't record its changes", rw.table.Name())}
+	}
+
+	// key of the row before and after the write
+	before, after := push.Row{}, push.Row{}
+	for _, pk := range rw.table.PrimaryKey() {
+		if value, exists := where[pk]; exists {
+			before[pk] = value
+		} else {
+			before[pk] = row[pk]
+		}
+		if value, exists := row[pk]; exists {
+			after[pk] = value
+		} else {
+			after[pk] = before[pk]
+		}
+	}
+
+	importedKey, err := rw.table.Import(before)
+	if err != nil {
+		return nil, err
+	}
+
+	pks := rw.table.PrimaryKey()
+	values := []interface{}{}
+	for _, pk := range pks {
+		values = append(values, rw.dd.dialect.ConvertValue(importedKey.GetOrNil(pk), ValueDescriptor{pk, false, rw.table.GetColumn(pk)}))
+	}
+
+	rows, err := readRows(rw.dd.tx, rw.dd.dialect, rw.tableName(), nil, pks, values)
+	if err != nil {
+		return nil, err
+	}
+
+	exists := len(rows) > 0
+
+	// insert, truncate and upsert modes overwrite the existing row depending on the conflict policy
+	overwrite := false
+	switch rw.dd.conflict.Resolve(rw.dd.mode) {
+	case push.ConflictOverwrite, push.ConflictOverwriteNonNull, push.ConflictKeepNewer:
+		overwrite = rw.dd.mode != push.Update && rw.dd.mode != push.Delete
+	}
+
+	switch {
+	case exists && (rw.dd.mode == push.Update || overwrite):
+		return push.Row{"table": rw.table.Name(), "action": journalUpdate, "key": after, "before": rows[0]}, nil
+	case exists && rw.dd.mode == push.Delete:
+		return push.Row{"table": rw.table.Name(), "action": journalDelete, "key": before, "before": rows[0]}, nil
+	case !exists && rw.dd.mode != push.Update && rw.dd.mode != push.Delete:
+		return push.Row{"table": rw.table.Name(), "action": journalInsert, "key": after}, nil
+	}
+
+	// the row is not changed
+	return nil, nil
+}
+
+// readRows selects columns (all columns if nil) of the rows of tableName matching the values of keys
+func readRows(q queryer, d SQLDialect, tableName string, columns []string, keys []string, values []interface{}) ([]push.Row, *push.Error) {
+	result := []push.Row{}
+
+	err := scanRows(q, d, tableName, columns, keys, values, func(row push.Row) *push.Error {
+		result = append(result, row)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// scanRows selects columns (all columns if nil) of the rows of tableName matching the values of keys, and calls
+// fn for each row as it is read
+func scanRows(q queryer, d SQLDialect, tableName string, columns []string, keys []string, values []interface{}, fn func(push.Row) *push.Error) *push.Error {
+	sb := &strings.Builder{}
+	sb.WriteString("SELECT ")
+	if len(columns) == 0 {
+		sb.WriteString("*")
+	}
+	for i, column := range columns {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(d.Quote(column))
+	}
+	sb.WriteString(" FROM ")
+	sb.WriteString(quoteTableName(d, tableName))
+
+	for i, key := range keys {
+		if i == 0 {
+			sb.WriteString(" WHERE ")
+		} else {
+			sb.WriteString(" AND ")
+		}
+		sb.WriteString(d.Quote(key) + "=" + d.Placeholder(i+1))
+	}
+
+	log.Debug().Msg(sb.String())
+
+	rows, err := q.Query(sb.String(), values...)
+	if err != nil {
+		return &push.Error{Description: err.Error()}
+	}
+	defer rows.Close() //nolint:errcheck
+
+	names, err := rows.Columns()
+	if err != nil {
+		return &push.Error{Description: err.Error()}
+	}
+
+	for rows.Next() {
+		scanned := make([]interface{}, len(names))
+		pointers := make([]interface{}, len(names))
+		for i := range scanned {
+			pointers[i] = &scanned[i]
+		}
+
+		if err := rows.Scan(pointers...); err != nil {
+			return &push.Error{Description: err.Error()}
+		}
+
+		row := push.Row{}
+		for i, name := range names {
+			if b, ok := scanned[i].([]byte); ok && utf8.Valid(b) {
+				row[name] = string(b)
+			} else {
+				row[name] = scanned[i]
+			}
+		}
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return &push.Error{Description: err.Error()}
+	}
+
+	return nil
+}
+
+// quoteTableName quotes each part of a table name prefixed by its schema
+func quoteTableName(d SQLDialect, tableName string) string {
+	parts := strings.Split(tableName, ".")
+	for i, part := range parts {
+		parts[i] = d.Quote(part)
+	}
+	return strings.Join(parts, ".")
+}
+
+// Undo replays the inverse of the changes recorded in journal, last change first, in a single transaction
+func (dd *SQLDataDestination) Undo(journal push.RowIterator) *push.Error {
+	entries := []push.Row{}
+	for journal.Next() {
+		entries = append(entries, *journal.Value())
+	}
+	if err := journal.Error(); err != nil {
+		return err
+	}
+
+	db, err := dburl.Open(dd.url)
+	if err != nil {
+		return &push.Error{Description: err.Error()}
+	}
+	defer db.Close() //nolint:errcheck
+
+	tx, err := db.Begin()
+	if err != nil {
+		return &push.Error{Description: err.Error()}
+	}
+
+	for i := len(entries) - 1; i >= 0; i-- {
+		if err := dd.undo(tx, entries[i]); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return &push.Error{Description: err.Error()}
+	}
+
+	log.Info().Int("entries", len(entries)).Msg("journal replayed")
+
+	return nil
+}
+
+// undo executes the inverse of a journal entry
+func (dd *SQLDataDestination) undo(tx *sql.Tx, entry push.Row) *push.Error {
+	tableName, _ := entry["table"].(string)
+	action, _ := entry["action"].(string)
+	key := toRow(entry["key"])
+	before := toRow(entry["before"])
+
+	pks := []string{}
+	for pk := range key {
+		pks = append(pks, pk)
+	}
+	sort.Strings(pks)
+
+	table := push.NewTable(tableName, pks, nil)
+
+	var mode push.Mode
+	var row push.Row
+
+	switch action {
+	case journalInsert:
+		mode = push.Delete
+	case journalUpdate:
+		mode, row = push.Update, before
+	case journalDelete, journalTruncate:
+		mode, row = push.Insert, before
+	default:
+		return &push.Error{Description: fmt.Sprintf("invalid action '%s' in journal", action)}
+	}
+
+	selectValues, whereValues := statementInfos(table, row, key)
+	if mode == push.Insert {
+		whereValues = nil
+	}
+
+	// columns in a stable order, the same entry always gives the same statement
+	sort.Slice(selectValues, func(i, j int) bool { return selectValues[i].name < selectValues[j].name })
+
+	statement, headers, err := buildStatement(dd.dialect, mode, qualifiedTableName(dd.schema, table), selectValues, whereValues, pks, "", push.ConflictDefault, "")
+	if err != nil {
+		return err
+	}
+
+	values := []interface{}{}
+	for _, h := range headers {
+		if value, exists := key[h.name]; exists && h.override {
+			values = append(values, dd.dialect.ConvertValue(value, h))
+		} else {
+			values = append(values, dd.dialect.ConvertValue(row[h.name], h))
+		}
+	}
+
+	log.Debug().Str("action", action).Msg(statement)
+
+	if _, err := tx.Exec(statement, values...); err != nil {
+		return &push.Error{Description: err.Error()}
+	}
+
+	return nil
+}
+
+func toRow(value push.Value) push.Row {
+	switch v := value.(type) {
+	case push.Row:
+		return v
+	case map[string]interface{}:
+		return push.Row(v)
+	default:
+		return push.Row{}
+	}
+}
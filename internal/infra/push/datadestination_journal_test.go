@@ -0,0 +1,169 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cgi-fr/lino/internal/infra/commonsql"
+	"github.com/cgi-fr/lino/pkg/push"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJournalUpdate(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	assert.Nil(t, err)
+	defer db.Close() //nolint:errcheck
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT \* FROM "customer" WHERE "id"=\$1`).WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, []byte("John")))
+	mock.ExpectPrepare(`UPDATE "customer" SET "name"=\$1 WHERE "id"=\$2`).
+		ExpectExec().WithArgs("Johnny", 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`SELECT \* FROM "customer" WHERE "id"=\$1`).WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
+	mock.ExpectExec(`UPDATE "customer" SET "name"=\$1 WHERE "id"=\$2`).WithArgs("Jim", 2).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	tx, err := db.Begin()
+	assert.Nil(t, err)
+
+	journal := &memoryReport{}
+	dd := NewSQLDataDestination("", "", PostgresDialect{innerDialect: commonsql.PostgresDialect{}})
+	dd.tx = tx
+	dd.mode = push.Update
+	dd.SetJournal(journal)
+
+	rw := NewSQLRowWriter(push.NewTable("customer", []string{"id"}, nil), dd)
+	assert.Nil(t, rw.Write(push.Row{"name": "Johnny"}, push.Row{"id": 1}))
+
+	// missing rows are not updated and not recorded
+	assert.Nil(t, rw.Write(push.Row{"name": "Jim"}, push.Row{"id": 2}))
+
+	assert.Empty(t, journal.rows)
+	assert.Nil(t, dd.writeJournal())
+	assert.Nil(t, mock.ExpectationsWereMet())
+
+	assert.Equal(t, []push.Row{
+		{"table": "customer", "action": "update", "key": push.Row{"id": 1}, "before": push.Row{"id": int64(1), "name": "John"}},
+	}, journal.rows)
+}
+
+func TestJournalInsertOverwrite(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	assert.Nil(t, err)
+	defer db.Close() //nolint:errcheck
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT \* FROM "customer" WHERE "id"=\$1`).WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, []byte("John")))
+	// the order of the columns of the pushed row is not stable
+	mock.ExpectPrepare(`INSERT INTO "customer" AS target\(.+\) VALUES \(\$1, \$2\) ON CONFLICT \(id\) DO UPDATE`).
+		ExpectExec().WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE "customer" SET "id"=\$1, "name"=\$2 WHERE "id"=\$3`).WithArgs(int64(1), "John", 1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	tx, err := db.Begin()
+	assert.Nil(t, err)
+
+	journal := &memoryReport{}
+	dd := NewSQLDataDestination("", "", PostgresDialect{innerDialect: commonsql.PostgresDialect{}})
+	dd.tx = tx
+	dd.mode = push.Insert
+	dd.SetConflictPolicy(push.ConflictOverwrite)
+	dd.SetJournal(journal)
+
+	rw := NewSQLRowWriter(push.NewTable("customer", []string{"id"}, nil), dd)
+	assert.Nil(t, rw.Write(push.Row{"id": 1, "name": "Johnny"}, nil))
+	assert.Nil(t, dd.writeJournal())
+
+	// the overwritten row is recorded and restored by undo
+	assert.Equal(t, []push.Row{
+		{"table": "customer", "action": "update", "key": push.Row{"id": 1}, "before": push.Row{"id": int64(1), "name": "John"}},
+	}, journal.rows)
+	assert.Nil(t, dd.undo(tx, journal.rows[0]))
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestJournalTruncateSnapshot(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	assert.Nil(t, err)
+	defer db.Close() //nolint:errcheck
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT \* FROM "order"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "customer_id"}).AddRow(10, 1))
+	mock.ExpectQuery(`SELECT \* FROM "customer"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, []byte("John")).AddRow(2, []byte("Jane")))
+
+	tx, err := db.Begin()
+	assert.Nil(t, err)
+
+	journal := &memoryReport{}
+	dd := NewSQLDataDestination("", "", PostgresDialect{innerDialect: commonsql.PostgresDialect{}})
+	dd.tx = tx
+	dd.mode = push.Truncate
+	dd.SetJournal(journal)
+
+	customer := push.NewTable("customer", []string{"id"}, nil)
+	order := push.NewTable("order", []string{"id"}, nil)
+	assert.Nil(t, dd.snapshot([]push.Table{customer, order}))
+	assert.Nil(t, mock.ExpectationsWereMet())
+
+	// children first, written before the truncate and without waiting for a commit
+	assert.Empty(t, dd.journalEntries)
+	assert.Equal(t, []push.Row{
+		{"table": "order", "action": "truncate", "key": push.Row{"id": int64(10)}, "before": push.Row{"id": int64(10), "customer_id": int64(1)}},
+		{"table": "customer", "action": "truncate", "key": push.Row{"id": int64(1)}, "before": push.Row{"id": int64(1), "name": "John"}},
+		{"table": "customer", "action": "truncate", "key": push.Row{"id": int64(2)}, "before": push.Row{"id": int64(2), "name": "Jane"}},
+	}, journal.rows)
+}
+
+func TestUndo(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	assert.Nil(t, err)
+	defer db.Close() //nolint:errcheck
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO "customer"\("name"\) VALUES \(\$1\)`).WithArgs("Jim").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE "customer" SET "name"=\$1 WHERE "id"=\$2`).WithArgs("John", 1.0).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM customer WHERE id=\$1`).WithArgs(3.0).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	tx, err := db.Begin()
+	assert.Nil(t, err)
+
+	dd := NewSQLDataDestination("", "", PostgresDialect{innerDialect: commonsql.PostgresDialect{}})
+
+	entries := []push.Row{
+		{"table": "customer", "action": "delete", "key": map[string]interface{}{"id": 2.0}, "before": map[string]interface{}{"name": "Jim"}},
+		{"table": "customer", "action": "update", "key": map[string]interface{}{"id": 1.0}, "before": map[string]interface{}{"name": "John"}},
+		{"table": "customer", "action": "insert", "key": map[string]interface{}{"id": 3.0}},
+	}
+
+	for _, entry := range entries {
+		assert.Nil(t, dd.undo(tx, entry))
+	}
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
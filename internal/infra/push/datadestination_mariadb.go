@@ -18,11 +18,18 @@
 package push
 
 import (
+	"database/sql"
+	"errors"
 	"fmt"
+	"io"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cgi-fr/lino/internal/infra/commonsql"
 	"github.com/cgi-fr/lino/pkg/push"
+	"github.com/go-sql-driver/mysql"
 	"github.com/lib/pq"
 )
 
@@ -39,6 +46,20 @@ func (e *MariadbDataDestinationFactory) New(url string, schema string) push.Data
 	return NewSQLDataDestination(url, schema, MariadbDialect{innerDialect: commonsql.MariadbDialect{}})
 }
 
+// NewScript return a Mariadb script writer
+func (e *MariadbDataDestinationFactory) NewScript(script io.Writer, schema string) (push.DataDestination, *push.Error) {
+	dd, err := NewScriptDataDestination(script, schema, MariadbDialect{innerDialect: commonsql.MariadbDialect{}})
+	if err != nil {
+		return nil, err
+	}
+	return dd, nil
+}
+
+// NewDiff return a Mariadb datadestination comparing pushed rows with the database
+func (e *MariadbDataDestinationFactory) NewDiff(url string, schema string, report push.RowWriter) push.DataDestination {
+	return NewSQLDiffDataDestination(url, schema, MariadbDialect{innerDialect: commonsql.MariadbDialect{}}, report)
+}
+
 // MariadbDialect inject mariadb variations
 type MariadbDialect struct {
 	innerDialect commonsql.Dialect
@@ -97,8 +118,44 @@ func (d MariadbDialect) InsertStatement(tableName string, selectValues []ValueDe
 	return sql.String(), selectValues
 }
 
-func (d MariadbDialect) UpsertStatement(tableName string, selectValues []ValueDescriptor, whereValues []ValueDescriptor, primaryKeys []string) (statement string, headers []ValueDescriptor, err *push.Error) {
-	return "", nil, &push.Error{Description: "upsert not implemented for mariadb"}
+// InsertReturningStatement reads back the generated key with LAST_INSERT_ID
+func (d MariadbDialect) InsertReturningStatement(tableName string, selectValues []ValueDescriptor, key string) (statement string, headers []ValueDescriptor, mode GeneratedKeyMode) {
+	statement, headers = d.InsertStatement(tableName, selectValues, nil)
+	return statement, headers, GeneratedKeyLastInsertID
+}
+
+// UpsertStatement generates an INSERT ... ON DUPLICATE KEY UPDATE statement applying policy
+func (d MariadbDialect) UpsertStatement(tableName string, selectValues []ValueDescriptor, whereValues []ValueDescriptor, primaryKeys []string, policy push.ConflictPolicy, version string) (statement string, headers []ValueDescriptor, err *push.Error) {
+	updates, err := conflictUpdates(tableName, selectValues, primaryKeys, policy, version)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if len(primaryKeys) == 0 || len(updates) == 0 {
+		statement, headers = d.InsertStatement(tableName, selectValues, primaryKeys)
+		return statement, headers, nil
+	}
+
+	statement, headers = d.InsertStatement(tableName, selectValues, nil)
+
+	sql := &strings.Builder{}
+	sql.WriteString(statement)
+	sql.WriteString(" ON DUPLICATE KEY UPDATE ")
+
+	for i, column := range updates {
+		if i > 0 {
+			sql.WriteString(", ")
+		}
+		quoted := d.innerDialect.Quote(column.name)
+		value := conflictValue(policy, quoted, "VALUES("+quoted+")")
+		if policy == push.ConflictKeepNewer {
+			// assignments are evaluated from left to right, the version column is updated last
+			value = "IF(VALUES(" + d.innerDialect.Quote(version) + ") > " + d.innerDialect.Quote(version) + ", " + value + ", " + quoted + ")"
+		}
+		sql.WriteString(quoted + " = " + value)
+	}
+
+	return sql.String(), headers, nil
 }
 
 func (d MariadbDialect) UpdateStatement(tableName string, selectValues []ValueDescriptor, whereValues []ValueDescriptor, primaryKeys []string) (statement string, headers []ValueDescriptor, err *push.Error) {
@@ -152,12 +209,68 @@ func (d MariadbDialect) UpdateStatement(tableName string, selectValues []ValueDe
 	return sql.String(), headers, nil
 }
 
+// BulkInsert loads rows with multi-row INSERT statements
+func (d MariadbDialect) BulkInsert(tx *sql.Tx, tableName string, headers []ValueDescriptor, primaryKeys []string, rows [][]interface{}) error {
+	statement, _ := d.InsertStatement(tableName, headers, primaryKeys)
+
+	return multiRowInsert(tx, d, statement, rows)
+}
+
+// SavepointStatement marks the point to roll back to when a bulk load fails
+func (d MariadbDialect) SavepointStatement(name string) string {
+	return "SAVEPOINT " + name
+}
+
+// RollbackToSavepointStatement cancels the statements executed since the savepoint
+func (d MariadbDialect) RollbackToSavepointStatement(name string) string {
+	return "ROLLBACK TO SAVEPOINT " + name
+}
+
 // IsDuplicateError check if error is a duplicate error
 func (d MariadbDialect) IsDuplicateError(err error) bool {
 	pqErr, ok := err.(*pq.Error)
 	return ok && pqErr.Code == "1452"
 }
 
+// IsTransientError check if error is a deadlock, a lock wait timeout or a connection failure
+func (d MariadbDialect) IsTransientError(err error) bool {
+	var myErr *mysql.MySQLError
+	if errors.As(err, &myErr) {
+		switch myErr.Number {
+		case 1205, 1213, 2006, 2013:
+			return true
+		}
+		return false
+	}
+	return errors.Is(err, mysql.ErrInvalidConn) || isConnectionError(err)
+}
+
+// mariadbConstraint matches the key or constraint name in MariaDB messages
+var mariadbConstraint = regexp.MustCompile("(?:for key '([^']+)'|CONSTRAINT `([^`]+)`)")
+
+// ErrorDetails returns the error number of a MariaDB error and the key or constraint found in its message
+func (d MariadbDialect) ErrorDetails(err error) (string, string) {
+	var myErr *mysql.MySQLError
+	if errors.As(err, &myErr) {
+		return strconv.Itoa(int(myErr.Number)), submatch(mariadbConstraint, myErr.Message)
+	}
+	return "", ""
+}
+
+// mariadbLiterals renders literal values in MariaDB scripts
+var mariadbLiterals = literalFormat{
+	// backslash is an escape character in MariaDB strings, unless NO_BACKSLASH_ESCAPES is set
+	str:       func(s string) string { return quoteString(strings.ReplaceAll(s, `\`, `\\`)) },
+	bytes:     func(hexa string) string { return "X'" + hexa + "'" },
+	boolean:   func(b bool) string { return strings.ToUpper(fmt.Sprint(b)) },
+	timestamp: func(t time.Time) string { return "'" + t.Format("2006-01-02 15:04:05.999999") + "'" },
+}
+
+// Literal renders value as a SQL literal
+func (d MariadbDialect) Literal(value push.Value) string {
+	return mariadbLiterals.literal(value)
+}
+
 // ConvertValue before load
 func (d MariadbDialect) ConvertValue(from push.Value, descriptor ValueDescriptor) push.Value {
 	return from
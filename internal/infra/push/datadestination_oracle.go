@@ -1,8 +1,12 @@
 package push
 
 import (
+	"database/sql"
 	"encoding/json"
 	"fmt"
+	"io"
+	"reflect"
+	"regexp"
 	"strings"
 	"time"
 
@@ -27,6 +31,20 @@ func (e *OracleDataDestinationFactory) New(url string, schema string) push.DataD
 	return NewSQLDataDestination(url, schema, OracleDialect{innerDialect: commonsql.OracleDialect{}})
 }
 
+// NewScript return an Oracle script writer
+func (e *OracleDataDestinationFactory) NewScript(script io.Writer, schema string) (push.DataDestination, *push.Error) {
+	dd, err := NewScriptDataDestination(script, schema, OracleDialect{innerDialect: commonsql.OracleDialect{}})
+	if err != nil {
+		return nil, err
+	}
+	return dd, nil
+}
+
+// NewDiff return a Oracle datadestination comparing pushed rows with the database
+func (e *OracleDataDestinationFactory) NewDiff(url string, schema string, report push.RowWriter) push.DataDestination {
+	return NewSQLDiffDataDestination(url, schema, OracleDialect{innerDialect: commonsql.OracleDialect{}}, report)
+}
+
 // OracleDialect inject oracle variations
 type OracleDialect struct {
 	innerDialect commonsql.Dialect
@@ -87,8 +105,19 @@ func (d OracleDialect) InsertStatement(tableName string, selectValues []ValueDes
 	return sql.String(), selectValues
 }
 
-// UpsertStatement
-func (d OracleDialect) UpsertStatement(tableName string, selectValues []ValueDescriptor, whereValues []ValueDescriptor, primaryKeys []string) (statement string, headers []ValueDescriptor, err *push.Error) {
+// InsertReturningStatement reads back the generated key with a RETURNING INTO clause
+func (d OracleDialect) InsertReturningStatement(tableName string, selectValues []ValueDescriptor, key string) (statement string, headers []ValueDescriptor, mode GeneratedKeyMode) {
+	statement, headers = d.InsertStatement(tableName, selectValues, nil)
+	return statement + " RETURNING " + d.Quote(key) + " INTO " + d.Placeholder(len(headers)+1), headers, GeneratedKeyOutParameter
+}
+
+// UpsertStatement generates a MERGE statement applying policy
+func (d OracleDialect) UpsertStatement(tableName string, selectValues []ValueDescriptor, whereValues []ValueDescriptor, primaryKeys []string, policy push.ConflictPolicy, version string) (statement string, headers []ValueDescriptor, err *push.Error) {
+	updates, err := conflictUpdates(tableName, selectValues, primaryKeys, policy, version)
+	if err != nil {
+		return "", nil, err
+	}
+
 	schemaAndTable := strings.Split(tableName, ".")
 
 	sql := &strings.Builder{}
@@ -116,18 +145,21 @@ func (d OracleDialect) UpsertStatement(tableName string, selectValues []ValueDes
 		}
 		sql.WriteString(fmt.Sprintf("target.%s = source.%s", d.innerDialect.Quote(pk), d.innerDialect.Quote(pk))) //nolint:staticcheck
 	}
-	sql.WriteString(") WHEN MATCHED THEN UPDATE SET ")
+	sql.WriteString(")")
 
-	first := true
-	for _, col := range selectValues {
-		if isAPrimaryKey(col.name, primaryKeys) {
-			continue
+	if len(updates) > 0 {
+		sql.WriteString(" WHEN MATCHED THEN UPDATE SET ")
+		for i, col := range updates {
+			if i > 0 {
+				sql.WriteString(", ")
+			}
+			quoted := d.innerDialect.Quote(col.name)
+			sql.WriteString("target." + quoted + " = " + conflictValue(policy, "target."+quoted, "source."+quoted))
 		}
-		if !first {
-			sql.WriteString(", ")
+
+		if policy == push.ConflictKeepNewer {
+			sql.WriteString(" WHERE source." + d.innerDialect.Quote(version) + " > target." + d.innerDialect.Quote(version))
 		}
-		sql.WriteString(fmt.Sprintf("target.%s = source.%s", d.innerDialect.Quote(col.name), d.innerDialect.Quote(col.name))) //nolint:staticcheck
-		first = false
 	}
 
 	sql.WriteString(" WHEN NOT MATCHED THEN INSERT (")
@@ -209,12 +241,177 @@ func (d OracleDialect) UpdateStatement(tableName string, selectValues []ValueDes
 	return sql.String(), headers, nil
 }
 
+// BulkInsert loads rows with a single insert statement using array binding, one array of values per column
+func (d OracleDialect) BulkInsert(tx *sql.Tx, tableName string, headers []ValueDescriptor, primaryKeys []string, rows [][]interface{}) error {
+	statement, _ := d.InsertStatement(tableName, headers, primaryKeys)
+
+	arrays := make([]interface{}, len(headers))
+	for i := range headers {
+		values := make([]interface{}, len(rows))
+		for j, row := range rows {
+			values[j] = row[i]
+		}
+		arrays[i] = oracleArray(values)
+	}
+
+	_, err := tx.Exec(statement, arrays...)
+
+	return err
+}
+
+// SavepointStatement marks the point to roll back to when a bulk load fails
+func (d OracleDialect) SavepointStatement(name string) string {
+	return "SAVEPOINT " + name
+}
+
+// RollbackToSavepointStatement cancels the statements executed since the savepoint
+func (d OracleDialect) RollbackToSavepointStatement(name string) string {
+	return "ROLLBACK TO SAVEPOINT " + name
+}
+
+// oracleArray converts the values of a column to a typed slice, go-ora binds all the values of an array with the
+// type of its elements. Integers become floats if the column mixes both, other mixes are bound as strings.
+func oracleArray(values []interface{}) interface{} {
+	kind := ""
+	for _, value := range values {
+		if value == nil {
+			continue
+		}
+		switch k := oracleKind(value); {
+		case kind == "" || kind == k:
+			kind = k
+		case (kind == "int" || kind == "float") && (k == "int" || k == "float"):
+			kind = "float"
+		default:
+			kind = "string"
+		}
+	}
+
+	switch kind {
+	case "blob":
+		array := make([]go_ora.Blob, len(values))
+		for i, value := range values {
+			if value != nil {
+				array[i] = value.(go_ora.Blob)
+			}
+		}
+		return array
+	case "bytes":
+		array := make([][]byte, len(values))
+		for i, value := range values {
+			if value != nil {
+				array[i] = value.([]byte)
+			}
+		}
+		return array
+	case "time":
+		array := make([]sql.NullTime, len(values))
+		for i, value := range values {
+			if value != nil {
+				array[i] = sql.NullTime{Time: value.(time.Time), Valid: true}
+			}
+		}
+		return array
+	case "bool":
+		array := make([]sql.NullBool, len(values))
+		for i, value := range values {
+			if value != nil {
+				array[i] = sql.NullBool{Bool: value.(bool), Valid: true}
+			}
+		}
+		return array
+	case "int":
+		array := make([]sql.NullInt64, len(values))
+		for i, value := range values {
+			if value != nil {
+				array[i] = sql.NullInt64{Int64: reflect.ValueOf(value).Convert(reflect.TypeOf(int64(0))).Int(), Valid: true}
+			}
+		}
+		return array
+	case "float":
+		array := make([]sql.NullFloat64, len(values))
+		for i, value := range values {
+			if value != nil {
+				array[i] = sql.NullFloat64{Float64: reflect.ValueOf(value).Convert(reflect.TypeOf(float64(0))).Float(), Valid: true}
+			}
+		}
+		return array
+	default:
+		array := make([]sql.NullString, len(values))
+		for i, value := range values {
+			if value != nil {
+				array[i] = sql.NullString{String: fmt.Sprintf("%v", value), Valid: true}
+			}
+		}
+		return array
+	}
+}
+
+// oracleKind returns the family of the type of a non null value
+func oracleKind(value interface{}) string {
+	switch value.(type) {
+	case go_ora.Blob:
+		return "blob"
+	case []byte:
+		return "bytes"
+	case time.Time:
+		return "time"
+	case bool:
+		return "bool"
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return "int"
+	case float32, float64:
+		return "float"
+	default:
+		return "string"
+	}
+}
+
 // IsDuplicateError check if error is a duplicate error
 func (d OracleDialect) IsDuplicateError(err error) bool {
 	// ORA-00001
 	return strings.Contains(err.Error(), "ORA-00001")
 }
 
+// IsTransientError check if error is a deadlock, a serialization failure, a busy resource or a lost connection
+func (d OracleDialect) IsTransientError(err error) bool {
+	for _, code := range []string{"ORA-00060", "ORA-08177", "ORA-00054", "ORA-03113", "ORA-03114", "ORA-03135"} {
+		if strings.Contains(err.Error(), code) {
+			return true
+		}
+	}
+	return isConnectionError(err)
+}
+
+var (
+	// oracleCode matches the error code in Oracle messages
+	oracleCode = regexp.MustCompile(`(ORA-\d{5})`)
+	// oracleConstraint matches the constraint name in Oracle messages
+	oracleConstraint = regexp.MustCompile(`constraint \(([^)]+)\)`)
+)
+
+// ErrorDetails returns the ORA code and the constraint found in an Oracle message
+func (d OracleDialect) ErrorDetails(err error) (string, string) {
+	return submatch(oracleCode, err.Error()), submatch(oracleConstraint, err.Error())
+}
+
+// oracleLiterals renders literal values in Oracle scripts
+var oracleLiterals = literalFormat{
+	bytes:   func(hexa string) string { return "HEXTORAW('" + hexa + "')" },
+	boolean: numericBoolean,
+	timestamp: func(t time.Time) string {
+		return "TIMESTAMP '" + t.Format("2006-01-02 15:04:05.999999999 -07:00") + "'"
+	},
+}
+
+// Literal renders value as a SQL literal
+func (d OracleDialect) Literal(value push.Value) string {
+	if blob, ok := value.(go_ora.Blob); ok {
+		return oracleLiterals.literal(blob.Data)
+	}
+	return oracleLiterals.literal(value)
+}
+
 // ConvertValue before load
 func (d OracleDialect) ConvertValue(from push.Value, descriptor ValueDescriptor) push.Value {
 	if descriptor.column != nil && (descriptor.column.Import() == "file" || descriptor.column.Import() == "blob") {
@@ -18,8 +18,12 @@
 package push
 
 import (
+	"database/sql"
+	"errors"
 	"fmt"
+	"io"
 	"strings"
+	"time"
 
 	"github.com/cgi-fr/lino/internal/infra/commonsql"
 	"github.com/cgi-fr/lino/pkg/push"
@@ -39,6 +43,20 @@ func (e *PostgresDataDestinationFactory) New(url string, schema string) push.Dat
 	return NewSQLDataDestination(url, schema, PostgresDialect{innerDialect: commonsql.PostgresDialect{}})
 }
 
+// NewScript return a Postgres script writer
+func (e *PostgresDataDestinationFactory) NewScript(script io.Writer, schema string) (push.DataDestination, *push.Error) {
+	dd, err := NewScriptDataDestination(script, schema, PostgresDialect{innerDialect: commonsql.PostgresDialect{}})
+	if err != nil {
+		return nil, err
+	}
+	return dd, nil
+}
+
+// NewDiff return a Postgres datadestination comparing pushed rows with the database
+func (e *PostgresDataDestinationFactory) NewDiff(url string, schema string, report push.RowWriter) push.DataDestination {
+	return NewSQLDiffDataDestination(url, schema, PostgresDialect{innerDialect: commonsql.PostgresDialect{}}, report)
+}
+
 // PostgresDialect inject postgres variations
 type PostgresDialect struct {
 	innerDialect commonsql.Dialect
@@ -114,7 +132,19 @@ func (d PostgresDialect) InsertStatement(tableName string, selectValues []ValueD
 	return sql.String(), selectValues
 }
 
-func (d PostgresDialect) UpsertStatement(tableName string, selectValues []ValueDescriptor, whereValues []ValueDescriptor, primaryKeys []string) (statement string, headers []ValueDescriptor, err *push.Error) {
+// InsertReturningStatement reads back the generated key with a RETURNING clause
+func (d PostgresDialect) InsertReturningStatement(tableName string, selectValues []ValueDescriptor, key string) (statement string, headers []ValueDescriptor, mode GeneratedKeyMode) {
+	statement, headers = d.InsertStatement(tableName, selectValues, nil)
+	return statement + " RETURNING " + d.Quote(key), headers, GeneratedKeyQuery
+}
+
+// UpsertStatement generates an INSERT ... ON CONFLICT DO UPDATE statement applying policy
+func (d PostgresDialect) UpsertStatement(tableName string, selectValues []ValueDescriptor, whereValues []ValueDescriptor, primaryKeys []string, policy push.ConflictPolicy, version string) (statement string, headers []ValueDescriptor, err *push.Error) {
+	updates, err := conflictUpdates(tableName, selectValues, primaryKeys, policy, version)
+	if err != nil {
+		return "", nil, err
+	}
+
 	protectedColumns := []string{}
 	for _, c := range selectValues {
 		protectedColumns = append(protectedColumns, fmt.Sprintf("\"%s\"", c.name))
@@ -129,7 +159,7 @@ func (d PostgresDialect) UpsertStatement(tableName string, selectValues []ValueD
 	} else {
 		sql.WriteString(d.innerDialect.Quote(schemaAndTable[0]) + "." + d.innerDialect.Quote(schemaAndTable[1]))
 	}
-	sql.WriteString("(")
+	sql.WriteString(" AS target(")
 	sql.WriteString(strings.Join(protectedColumns, ","))
 	sql.WriteString(") VALUES (")
 	for i := 1; i <= len(selectValues); i++ {
@@ -139,25 +169,29 @@ func (d PostgresDialect) UpsertStatement(tableName string, selectValues []ValueD
 		}
 	}
 
-	if len(primaryKeys) > 0 {
+	switch {
+	case len(primaryKeys) == 0:
+		sql.WriteString(")")
+	case len(updates) == 0:
+		sql.WriteString(") ON CONFLICT (")
+		sql.WriteString(strings.Join(primaryKeys, ","))
+		sql.WriteString(") DO NOTHING")
+	default:
 		sql.WriteString(") ON CONFLICT (")
 		sql.WriteString(strings.Join(primaryKeys, ","))
 		sql.WriteString(") DO UPDATE SET ")
 
-		first := true
-		for _, column := range selectValues {
-			// Skip primary keys in update set
-			if isAPrimaryKey(column.name, primaryKeys) {
-				continue
-			}
-			if !first {
+		for i, column := range updates {
+			if i > 0 {
 				sql.WriteString(", ")
 			}
-			sql.WriteString(fmt.Sprintf("\"%s\" = EXCLUDED.\"%s\"", column.name, column.name)) //nolint:staticcheck
-			first = false
+			quoted := d.innerDialect.Quote(column.name)
+			sql.WriteString(quoted + " = " + conflictValue(policy, "target."+quoted, "EXCLUDED."+quoted))
+		}
+
+		if policy == push.ConflictKeepNewer {
+			sql.WriteString(" WHERE EXCLUDED." + d.innerDialect.Quote(version) + " > target." + d.innerDialect.Quote(version))
 		}
-	} else {
-		sql.WriteString(")")
 	}
 
 	return sql.String(), selectValues, nil
@@ -219,12 +253,79 @@ func (d PostgresDialect) UpdateStatement(tableName string, selectValues []ValueD
 	return sql.String(), headers, nil
 }
 
+// BulkInsert loads rows with COPY FROM STDIN
+func (d PostgresDialect) BulkInsert(tx *sql.Tx, tableName string, headers []ValueDescriptor, primaryKeys []string, rows [][]interface{}) error {
+	protectedColumns := []string{}
+	for _, c := range headers {
+		protectedColumns = append(protectedColumns, d.innerDialect.Quote(c.name))
+	}
+
+	schemaAndTable := strings.Split(tableName, ".")
+
+	sb := &strings.Builder{}
+	sb.WriteString("COPY ")
+	if len(schemaAndTable) == 1 {
+		sb.WriteString(d.innerDialect.Quote(schemaAndTable[0]))
+	} else {
+		sb.WriteString(d.innerDialect.Quote(schemaAndTable[0]) + "." + d.innerDialect.Quote(schemaAndTable[1]))
+	}
+	sb.WriteString(" (")
+	sb.WriteString(strings.Join(protectedColumns, ", "))
+	sb.WriteString(") FROM STDIN")
+
+	return copyIn(tx, sb.String(), rows)
+}
+
+// SavepointStatement marks the point to roll back to when a bulk load fails
+func (d PostgresDialect) SavepointStatement(name string) string {
+	return "SAVEPOINT " + name
+}
+
+// RollbackToSavepointStatement cancels the statements executed since the savepoint
+func (d PostgresDialect) RollbackToSavepointStatement(name string) string {
+	return "ROLLBACK TO SAVEPOINT " + name
+}
+
 // IsDuplicateError check if error is a duplicate error
 func (d PostgresDialect) IsDuplicateError(err error) bool {
 	pqErr, ok := err.(*pq.Error)
 	return ok && pqErr.Code == "23505"
 }
 
+// IsTransientError check if error is a serialization failure, a deadlock, a lock timeout or a connection failure
+func (d PostgresDialect) IsTransientError(err error) bool {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code {
+		case "40001", "40P01", "55P03", "57P01", "57P02", "57P03":
+			return true
+		}
+		return pqErr.Code.Class() == "08"
+	}
+	return isConnectionError(err)
+}
+
+// ErrorDetails returns the SQLSTATE and the constraint of a PostgreSQL error
+func (d PostgresDialect) ErrorDetails(err error) (string, string) {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return string(pqErr.Code), pqErr.Constraint
+	}
+	return "", ""
+}
+
+// postgresLiterals renders literal values in PostgreSQL scripts
+var postgresLiterals = literalFormat{
+	bytes:     func(hexa string) string { return "decode('" + hexa + "', 'hex')" },
+	boolean:   func(b bool) string { return strings.ToUpper(fmt.Sprint(b)) },
+	timestamp: func(t time.Time) string { return "'" + t.Format("2006-01-02 15:04:05.999999Z07:00") + "'" },
+}
+
+// Literal renders value as a SQL literal
+func (d PostgresDialect) Literal(value push.Value) string {
+	return postgresLiterals.literal(value)
+}
+
 // ConvertValue before load
 func (d PostgresDialect) ConvertValue(from push.Value, descriptor ValueDescriptor) push.Value {
 	return from
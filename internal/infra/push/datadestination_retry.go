@@ -0,0 +1,94 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+import (
+	"database/sql/driver"
+	"errors"
+	"io"
+	"net"
+	"strings"
+
+	"github.com/cgi-fr/lino/pkg/push"
+	"github.com/rs/zerolog/log"
+)
+
+// Rollback cancels the changes since the last commit and starts a new transaction, on a new connection if reconnect is true
+func (dd *SQLDataDestination) Rollback(reconnect bool) *push.Error {
+	for _, rw := range dd.rowWriter {
+		if err := rw.close(); err != nil {
+			log.Debug().Str("table", rw.table.Name()).AnErr("error", err).Msg("Error during row writer closing")
+		}
+		rw.statement = nil
+		rw.keyStatement = nil
+		rw.bulkRows = nil
+		rw.headers = nil
+	}
+
+	// entries of the rolled back transaction only, the truncate snapshot is already written in the journal
+	dd.journalEntries = nil
+
+	if err := dd.tx.Rollback(); err != nil {
+		log.Debug().AnErr("error", err).Msg("Error during rollback")
+	} else {
+		log.Debug().Msg("transaction rolled back")
+	}
+
+	if reconnect {
+		if err := dd.db.Close(); err != nil {
+			log.Debug().AnErr("error", err).Msg("Error during db closing")
+		}
+		if err := dd.connect(); err != nil {
+			return err
+		}
+		log.Info().Str("url", dd.SafeUrl()).Msg("Reconnected to database")
+
+		// session settings such as FOREIGN_KEY_CHECKS are lost with the previous connection
+		if dd.disableConstraints {
+			for _, rw := range dd.rowWriter {
+				// constraints are listed again, keep each one once to restore it once at close
+				rw.disabledConstraints = rw.disabledConstraints[:0]
+				if err := rw.disableConstraints(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	tx, err := dd.db.Begin()
+	if err != nil {
+		return &push.Error{Description: err.Error(), Transient: dd.dialect.IsTransientError(err)}
+	}
+	dd.tx = tx
+
+	return nil
+}
+
+// isConnectionError returns true if err is caused by a lost or refused connection
+func isConnectionError(err error) bool {
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	return strings.Contains(err.Error(), "connection reset") || strings.Contains(err.Error(), "broken pipe")
+}
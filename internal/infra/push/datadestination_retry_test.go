@@ -0,0 +1,126 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+import (
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cgi-fr/lino/internal/infra/commonsql"
+	"github.com/cgi-fr/lino/pkg/push"
+	"github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	mssql "github.com/microsoft/go-mssqldb" //nolint:staticcheck
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsTransientError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		dialect   SQLDialect
+		err       error
+		transient bool
+	}{
+		{"postgres deadlock", PostgresDialect{}, &pq.Error{Code: "40P01"}, true},
+		{"postgres serialization", PostgresDialect{}, &pq.Error{Code: "40001"}, true},
+		{"postgres connection", PostgresDialect{}, &pq.Error{Code: "08006"}, true},
+		{"postgres duplicate", PostgresDialect{}, &pq.Error{Code: "23505"}, false},
+		{"postgres bad connection", PostgresDialect{}, driver.ErrBadConn, true},
+		{"sqlserver deadlock", SQLServerDialect{}, mssql.Error{Number: 1205}, true},
+		{"sqlserver duplicate", SQLServerDialect{}, mssql.Error{Number: 2627}, false},
+		{"mariadb deadlock", MariadbDialect{}, &mysql.MySQLError{Number: 1213}, true},
+		{"mariadb lock timeout", MariadbDialect{}, &mysql.MySQLError{Number: 1205}, true},
+		{"mariadb duplicate", MariadbDialect{}, &mysql.MySQLError{Number: 1062}, false},
+		{"mariadb invalid connection", MariadbDialect{}, mysql.ErrInvalidConn, true},
+		{"oracle deadlock", OracleDialect{}, errors.New("ORA-00060: deadlock detected while waiting for resource"), true},
+		{"oracle duplicate", OracleDialect{}, errors.New("ORA-00001: unique constraint violated"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.transient, tt.dialect.IsTransientError(tt.err))
+		})
+	}
+}
+
+func TestErrorDetails(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		dialect    SQLDialect
+		err        error
+		code       string
+		constraint string
+	}{
+		{"postgres", PostgresDialect{}, &pq.Error{Code: "23505", Constraint: "customer_pkey"}, "23505", "customer_pkey"},
+		{"sqlserver", SQLServerDialect{}, mssql.Error{Number: 2627, Message: "Violation of PRIMARY KEY constraint 'PK_customer'. Cannot insert duplicate key in object 'dbo.customer'."}, "2627", "PK_customer"},
+		{"sqlserver foreign key", SQLServerDialect{}, mssql.Error{Number: 547, Message: `The INSERT statement conflicted with the FOREIGN KEY constraint "FK_order_customer".`}, "547", "FK_order_customer"},
+		{"mariadb", MariadbDialect{}, &mysql.MySQLError{Number: 1062, Message: "Duplicate entry '1' for key 'PRIMARY'"}, "1062", "PRIMARY"},
+		{"mariadb foreign key", MariadbDialect{}, &mysql.MySQLError{Number: 1452, Message: "Cannot add or update a child row: a foreign key constraint fails (`db`.`order`, CONSTRAINT `fk_customer` FOREIGN KEY (`customer_id`) REFERENCES `customer` (`id`))"}, "1452", "fk_customer"},
+		{"oracle", OracleDialect{}, errors.New("ORA-00001: unique constraint (APP.PK_CUSTOMER) violated"), "ORA-00001", "APP.PK_CUSTOMER"},
+		{"unknown", PostgresDialect{}, errors.New("unknown error"), "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			code, constraint := tt.dialect.ErrorDetails(tt.err)
+			assert.Equal(t, tt.code, code)
+			assert.Equal(t, tt.constraint, constraint)
+		})
+	}
+}
+
+func TestRollbackAfterTransientError(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	assert.Nil(t, err)
+	defer db.Close() //nolint:errcheck
+
+	mock.ExpectBegin()
+	mock.ExpectPrepare(`INSERT INTO "customer"\("id"\) VALUES \(\$1\)`).
+		ExpectExec().WithArgs(1).WillReturnError(&pq.Error{Code: "40P01", Message: "deadlock detected"})
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectPrepare(`INSERT INTO "customer"\("id"\) VALUES \(\$1\)`).
+		ExpectExec().WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	dd := NewSQLDataDestination("", "", PostgresDialect{innerDialect: commonsql.PostgresDialect{}})
+	dd.db = sqlx.NewDb(db, "postgres")
+	dd.tx, err = db.Begin()
+	assert.Nil(t, err)
+
+	rw := NewSQLRowWriter(push.NewTable("customer", []string{"id"}, nil), dd)
+	dd.rowWriter["customer"] = rw
+
+	pusherr := rw.Write(push.Row{"id": 1}, nil)
+	assert.NotNil(t, pusherr)
+	assert.True(t, pusherr.Transient)
+
+	assert.Nil(t, dd.Rollback(false))
+	assert.Nil(t, rw.Write(push.Row{"id": 1}, nil))
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
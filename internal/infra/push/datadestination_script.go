@@ -0,0 +1,317 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cgi-fr/lino/pkg/push"
+	"github.com/rs/zerolog/log"
+)
+
+// SQLScriptDialect is implemented by dialects able to render values as SQL literals
+type SQLScriptDialect interface {
+	// Literal renders value as a SQL literal
+	Literal(value push.Value) string
+}
+
+// literalFormat describes how a dialect writes literal values that have no standard form
+type literalFormat struct {
+	str       func(s string) string // standard quoting of strings if nil
+	bytes     func(hexa string) string
+	boolean   func(b bool) string
+	timestamp func(t time.Time) string
+}
+
+// literal renders value as a SQL literal
+func (f literalFormat) literal(value push.Value) string {
+	switch v := value.(type) {
+	case nil:
+		return "NULL"
+	case string:
+		return f.quote(v)
+	case json.Number:
+		return v.String()
+	case bool:
+		return f.boolean(v)
+	case []byte:
+		return f.bytes(hex.EncodeToString(v))
+	case time.Time:
+		return f.timestamp(v)
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", v)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return f.quote(fmt.Sprintf("%v", v))
+	}
+}
+
+func (f literalFormat) quote(s string) string {
+	if f.str != nil {
+		return f.str(s)
+	}
+	return quoteString(s)
+}
+
+func quoteString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+func numericBoolean(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
+
+// ScriptDataDestination writes the push as a SQL script instead of executing it.
+type ScriptDataDestination struct {
+	script             *bufio.Writer
+	schema             string
+	dialect            SQLDialect
+	literals           SQLScriptDialect
+	mode               push.Mode
+	disableConstraints bool
+	whereClause        string
+	startTableName     string
+	tables             []push.Table
+	rowWriter          map[string]*ScriptRowWriter
+	conflict           push.ConflictPolicy
+}
+
+// NewScriptDataDestination creates a new datadestination writing SQL statements of the dialect to script.
+func NewScriptDataDestination(script io.Writer, schema string, dialect SQLDialect) (*ScriptDataDestination, *push.Error) {
+	literals, ok := dialect.(SQLScriptDialect)
+	if !ok {
+		return nil, &push.Error{Description: "SQL script is not supported by this dialect"}
+	}
+
+	return &ScriptDataDestination{
+		script:    bufio.NewWriter(script),
+		schema:    schema,
+		dialect:   dialect,
+		literals:  literals,
+		rowWriter: map[string]*ScriptRowWriter{},
+	}, nil
+}
+
+// SetConflictPolicy sets the policy applied on primary key conflicts in insert, truncate and upsert modes
+func (dd *ScriptDataDestination) SetConflictPolicy(policy push.ConflictPolicy) {
+	dd.conflict = policy
+}
+
+// SafeUrl return the description of the datadestination
+func (dd *ScriptDataDestination) SafeUrl() string {
+	return "script"
+}
+
+// OpenSQLLogger is not available, the script already contains all statements
+func (dd *ScriptDataDestination) OpenSQLLogger(folderPath string) error {
+	return fmt.Errorf("SQL logger is not available when writing a script")
+}
+
+// Open writes the statements to disable constraints and truncate tables
+func (dd *ScriptDataDestination) Open(plan push.Plan, mode push.Mode, disableConstraints bool, whereClause string) *push.Error {
+	dd.mode = mode
+	dd.disableConstraints = disableConstraints
+	dd.whereClause = whereClause
+	if plan.FirstTable() != nil {
+		dd.startTableName = plan.FirstTable().Name()
+	}
+
+	for _, table := range plan.Tables() {
+		dd.tables = append(dd.tables, table)
+		dd.rowWriter[table.Name()] = &ScriptRowWriter{table: table, dd: dd}
+
+		if disableConstraints {
+			if err := dd.write(dd.dialect.DisableConstraintsStatement(qualifiedTableName(dd.schema, table))); err != nil {
+				return err
+			}
+		}
+
+		if mode == push.Truncate {
+			if err := dd.write(dd.dialect.TruncateStatement(qualifiedTableName(dd.schema, table))); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// RowWriter return the script writer of the table
+func (dd *ScriptDataDestination) RowWriter(table push.Table) (push.RowWriter, *push.Error) {
+	rw, ok := dd.rowWriter[table.Name()]
+	if !ok {
+		rw = &ScriptRowWriter{table: table, dd: dd}
+		dd.rowWriter[table.Name()] = rw
+	}
+	return rw, nil
+}
+
+// Commit writes a COMMIT marker
+func (dd *ScriptDataDestination) Commit() *push.Error {
+	return dd.write("COMMIT")
+}
+
+// Close writes the last COMMIT marker and the statements to enable constraints
+func (dd *ScriptDataDestination) Close() *push.Error {
+	if err := dd.write("COMMIT"); err != nil {
+		return err
+	}
+
+	if dd.disableConstraints {
+		for _, table := range dd.tables {
+			if err := dd.write(dd.dialect.EnableConstraintsStatement(qualifiedTableName(dd.schema, table))); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := dd.script.Flush(); err != nil {
+		return &push.Error{Description: err.Error()}
+	}
+	return nil
+}
+
+// write a statement with its terminator, PL/SQL blocks are terminated by a slash
+func (dd *ScriptDataDestination) write(statement string) *push.Error {
+	terminator := ";\n"
+	if strings.HasPrefix(strings.TrimSpace(statement), "BEGIN") {
+		terminator = "\n/\n"
+	} else if strings.HasSuffix(statement, ";") {
+		terminator = "\n"
+	}
+
+	if _, err := dd.script.WriteString(statement + terminator); err != nil {
+		return &push.Error{Description: err.Error()}
+	}
+	return nil
+}
+
+// ScriptRowWriter writes rows of a table as literal SQL statements.
+type ScriptRowWriter struct {
+	table push.Table
+	dd    *ScriptDataDestination
+}
+
+// Write the statement for row with literal values
+func (rw *ScriptRowWriter) Write(row push.Row, where push.Row) *push.Error {
+	dialect := rw.dd.dialect
+
+	tableName := qualifiedTableName(rw.dd.schema, rw.table)
+
+	whereClause := ""
+	if tableName == rw.dd.startTableName {
+		whereClause = rw.dd.whereClause
+	}
+
+	selectValues, whereValues := statementInfos(rw.table, row, where)
+
+	statement, headers, err := buildStatement(dialect, rw.dd.mode, tableName, selectValues, whereValues, rw.table.PrimaryKey(), whereClause, rw.dd.conflict, rw.table.Version())
+	if err != nil {
+		return err
+	}
+
+	importedRow, err := rw.table.Import(row)
+	if err != nil {
+		return err
+	}
+
+	literals := []string{}
+	for _, h := range headers {
+		var value push.Value
+		if oldvalue, exists := where[h.name]; exists && h.override {
+			value = dialect.ConvertValue(oldvalue, h)
+		} else {
+			value = dialect.ConvertValue(importedRow.GetOrNil(h.name), h)
+		}
+		literals = append(literals, rw.dd.literals.Literal(value))
+	}
+
+	log.Trace().Str("table", rw.table.Name()).Msg(statement)
+
+	return rw.dd.write(bindLiterals(dialect, statement, literals))
+}
+
+// bindLiterals replaces the placeholders of statement by literal values in a single pass, quoted strings and
+// identifiers (of the where clause for example) are copied as is
+func bindLiterals(d SQLDialect, statement string, literals []string) string {
+	prefix := d.Placeholder(1)
+	positional := prefix == d.Placeholder(2)
+	if !positional {
+		prefix = strings.TrimSuffix(prefix, "1")
+	}
+
+	sb := &strings.Builder{}
+	index := 0
+
+	for i := 0; i < len(statement); {
+		switch c := statement[i]; {
+		case c == '\'' || c == '"' || c == '`':
+			// a doubled quote inside a quoted section ends it and starts a new one
+			end := strings.IndexByte(statement[i+1:], c)
+			if end < 0 {
+				sb.WriteString(statement[i:])
+				return sb.String()
+			}
+			sb.WriteString(statement[i : i+end+2])
+			i += end + 2
+
+		case strings.HasPrefix(statement[i:], prefix):
+			i += len(prefix)
+			if positional {
+				if index < len(literals) {
+					sb.WriteString(literals[index])
+					index++
+				} else {
+					sb.WriteString(prefix)
+				}
+				continue
+			}
+
+			digits := i
+			for digits < len(statement) && statement[digits] >= '0' && statement[digits] <= '9' {
+				digits++
+			}
+			position, err := strconv.Atoi(statement[i:digits])
+			if err != nil || position < 1 || position > len(literals) {
+				sb.WriteString(prefix + statement[i:digits])
+			} else {
+				sb.WriteString(literals[position-1])
+			}
+			i = digits
+
+		default:
+			sb.WriteByte(c)
+			i++
+		}
+	}
+
+	return sb.String()
+}
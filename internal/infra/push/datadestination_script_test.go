@@ -0,0 +1,133 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cgi-fr/lino/internal/infra/commonsql"
+	"github.com/cgi-fr/lino/pkg/push"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScriptDataDestinationPostgres(t *testing.T) {
+	t.Parallel()
+
+	script := &strings.Builder{}
+	dd, err := NewScriptDataDestination(script, "public", PostgresDialect{innerDialect: commonsql.PostgresDialect{}})
+	assert.Nil(t, err)
+
+	customer := push.NewTable("customer", []string{"id"}, nil)
+	plan := push.NewPlan(customer, []push.Relation{})
+
+	assert.Nil(t, dd.Open(plan, push.Truncate, true, ""))
+
+	rw, err := dd.RowWriter(customer)
+	assert.Nil(t, err)
+	assert.Nil(t, rw.Write(push.Row{"name": "O'Neil"}, nil))
+	assert.Nil(t, dd.Commit())
+	assert.Nil(t, rw.Write(push.Row{"name": nil}, nil))
+	assert.Nil(t, dd.Close())
+
+	assert.Equal(t, `ALTER TABLE "public"."customer" DISABLE TRIGGER ALL;
+TRUNCATE TABLE "public"."customer" CASCADE;
+INSERT INTO "public"."customer"("name") VALUES ('O''Neil') ON CONFLICT (id) DO NOTHING;
+COMMIT;
+INSERT INTO "public"."customer"("name") VALUES (NULL) ON CONFLICT (id) DO NOTHING;
+COMMIT;
+ALTER TABLE "public"."customer" ENABLE TRIGGER ALL;
+`, script.String())
+}
+
+func TestScriptDataDestinationDelete(t *testing.T) {
+	t.Parallel()
+
+	script := &strings.Builder{}
+	dd, err := NewScriptDataDestination(script, "", MariadbDialect{innerDialect: commonsql.MariadbDialect{}})
+	assert.Nil(t, err)
+
+	customer := push.NewTable("customer", []string{"id", "version"}, nil)
+	plan := push.NewPlan(customer, []push.Relation{})
+
+	assert.Nil(t, dd.Open(plan, push.Delete, false, ""))
+
+	rw, err := dd.RowWriter(customer)
+	assert.Nil(t, err)
+	assert.Nil(t, rw.Write(push.Row{"id": 1, "version": "?"}, push.Row{}))
+	assert.Nil(t, dd.Close())
+
+	assert.Equal(t, "DELETE FROM customer WHERE id=1 and version='?';\nCOMMIT;\n", script.String())
+}
+
+func TestLiterals(t *testing.T) {
+	t.Parallel()
+
+	date := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	assert.Equal(t, "decode('0aff', 'hex')", PostgresDialect{}.Literal([]byte{0x0a, 0xff}))
+	assert.Equal(t, "0x0aff", SQLServerDialect{}.Literal([]byte{0x0a, 0xff}))
+	assert.Equal(t, "X'0aff'", MariadbDialect{}.Literal([]byte{0x0a, 0xff}))
+	assert.Equal(t, `'C:\\temp\\'`, MariadbDialect{}.Literal(`C:\temp\`))
+	assert.Equal(t, `'\\'' OR 1=1 --'`, MariadbDialect{}.Literal(`\' OR 1=1 --`))
+	assert.Equal(t, `'C:\temp\'`, PostgresDialect{}.Literal(`C:\temp\`))
+	assert.Equal(t, "HEXTORAW('0aff')", OracleDialect{}.Literal([]byte{0x0a, 0xff}))
+	assert.Equal(t, "TRUE", PostgresDialect{}.Literal(true))
+	assert.Equal(t, "0", SQLServerDialect{}.Literal(false))
+	assert.Equal(t, "'2026-01-02 03:04:05Z'", PostgresDialect{}.Literal(date))
+	assert.Equal(t, "TIMESTAMP '2026-01-02 03:04:05 +00:00'", OracleDialect{}.Literal(date))
+	assert.Equal(t, "1.5", PostgresDialect{}.Literal(1.5))
+}
+
+func TestBindLiterals(t *testing.T) {
+	t.Parallel()
+
+	literals := []string{"'$2'", "2", "3", "4", "5", "6", "7", "8", "9", "10"}
+
+	assert.Equal(t,
+		"VALUES ('$2', 2, 3, 4, 5, 6, 7, 8, 9, 10)",
+		bindLiterals(PostgresDialect{innerDialect: commonsql.PostgresDialect{}}, "VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)", literals),
+	)
+	assert.Equal(t,
+		"VALUES ('$2', 2)",
+		bindLiterals(MariadbDialect{innerDialect: commonsql.MariadbDialect{}}, "VALUES (?, ?)", literals),
+	)
+
+	// placeholders in quoted strings and identifiers of the where clause are not replaced
+	assert.Equal(t,
+		`UPDATE "customer" SET "name"='$2' WHERE "id"=2 AND (note <> 'it''s $1' AND "$1" = 1)`,
+		bindLiterals(PostgresDialect{innerDialect: commonsql.PostgresDialect{}}, `UPDATE "customer" SET "name"=$1 WHERE "id"=$2 AND (note <> 'it''s $1' AND "$1" = 1)`, literals),
+	)
+	assert.Equal(t,
+		"UPDATE customer SET `name`='$2' WHERE id=2 AND (note <> '?')",
+		bindLiterals(MariadbDialect{innerDialect: commonsql.MariadbDialect{}}, "UPDATE customer SET `name`=? WHERE id=? AND (note <> '?')", literals),
+	)
+}
+
+// noLiteralDialect hides the literal rendering of its dialect
+type noLiteralDialect struct {
+	SQLDialect
+}
+
+func TestScriptDataDestinationUnsupportedDialect(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewScriptDataDestination(&strings.Builder{}, "", noLiteralDialect{PostgresDialect{innerDialect: commonsql.PostgresDialect{}}})
+	assert.NotNil(t, err)
+}
@@ -21,6 +21,7 @@
 	"database/sql"
 	"fmt"
 	"net/url"
+	"regexp"
 	"slices"
 	"strings"
 
@@ -43,6 +44,11 @@ type SQLDataDestination struct {
 	sqlLogger          *SQLLogger
 	whereClause        string
 	startTableName     string
+	bulk               bool
+	tableOrder         []string
+	journal            push.RowWriter
+	journalEntries     []push.Row
+	conflict           push.ConflictPolicy
 }
 
 // NewSQLDataDestination creates a new SQL datadestination.
@@ -55,6 +61,11 @@ func NewSQLDataDestination(url string, schema string, dialect SQLDialect) *SQLDa
 	}
 }
 
+// SetConflictPolicy sets the policy applied on primary key conflicts in insert, truncate and upsert modes
+func (dd *SQLDataDestination) SetConflictPolicy(policy push.ConflictPolicy) {
+	dd.conflict = policy
+}
+
 func (dd *SQLDataDestination) SafeUrl() string {
 	u, err := url.Parse(dd.url)
 	if err != nil {
@@ -69,6 +80,11 @@ func (dd *SQLDataDestination) SafeUrl() string {
 func (dd *SQLDataDestination) Close() *push.Error {
 	errors := []*push.Error{}
 
+	if err := dd.flush(); err != nil {
+		log.Warn().AnErr("error", err).Msg("Error during bulk load")
+		errors = append(errors, err)
+	}
+
 	for _, rw := range dd.rowWriter {
 		err := rw.close()
 		if err != nil {
@@ -82,6 +98,9 @@ func (dd *SQLDataDestination) Close() *push.Error {
 		errors = append(errors, &push.Error{Description: err.Error()})
 	} else {
 		log.Debug().Msg("transaction committed")
+		if err := dd.writeJournal(); err != nil {
+			errors = append(errors, err)
+		}
 	}
 
 	if dd.disableConstraints {
@@ -112,6 +131,10 @@ func (dd *SQLDataDestination) Close() *push.Error {
 
 // Commit SQL for connection
 func (dd *SQLDataDestination) Commit() *push.Error {
+	if err := dd.flush(); err != nil {
+		return err
+	}
+
 	for _, rw := range dd.rowWriter {
 		err := rw.close()
 		if err != nil {
@@ -121,10 +144,14 @@ func (dd *SQLDataDestination) Commit() *push.Error {
 
 	err := dd.tx.Commit()
 	if err != nil {
-		return &push.Error{Description: err.Error()}
+		return dd.writeError(err)
 	}
 	log.Debug().Msg("transaction committed")
 
+	if err := dd.writeJournal(); err != nil {
+		return err
+	}
+
 	tx, err := dd.db.Begin()
 	if err != nil {
 		return &push.Error{Description: err.Error()}
@@ -144,32 +171,25 @@ func (dd *SQLDataDestination) Open(plan push.Plan, mode push.Mode, disableConstr
 		dd.startTableName = plan.FirstTable().Name()
 	}
 
-	db, err := dburl.Open(dd.url)
-	if err != nil {
-		return &push.Error{Description: err.Error()}
+	if err := dd.connect(); err != nil {
+		return err
 	}
 
-	u, err := dburl.Parse(dd.url)
-	if err != nil {
-		return &push.Error{Description: err.Error()}
-	}
-
-	dbx := sqlx.NewDb(db, u.UnaliasedDriver)
-
-	err = dbx.Ping()
-	if err != nil {
-		return &push.Error{Description: err.Error()}
-	}
-
-	dd.db = dbx
-
 	tx, err := dd.db.Begin()
 	if err != nil {
 		return &push.Error{Description: err.Error()}
 	}
 	dd.tx = tx
 
+	if dd.journal != nil && mode == push.Truncate {
+		if err := dd.snapshot(plan.Tables()); err != nil {
+			return err
+		}
+	}
+
 	for _, table := range plan.Tables() {
+		dd.tableOrder = append(dd.tableOrder, table.Name())
+
 		rw := NewSQLRowWriter(table, dd)
 		err := rw.open()
 		if err != nil {
@@ -182,6 +202,30 @@ func (dd *SQLDataDestination) Open(plan push.Plan, mode push.Mode, disableConstr
 	return nil
 }
 
+// connect opens the database connection
+func (dd *SQLDataDestination) connect() *push.Error {
+	db, err := dburl.Open(dd.url)
+	if err != nil {
+		return &push.Error{Description: err.Error()}
+	}
+
+	u, err := dburl.Parse(dd.url)
+	if err != nil {
+		return &push.Error{Description: err.Error()}
+	}
+
+	dbx := sqlx.NewDb(db, u.UnaliasedDriver)
+
+	err = dbx.Ping()
+	if err != nil {
+		return &push.Error{Description: err.Error(), Transient: dd.dialect.IsTransientError(err)}
+	}
+
+	dd.db = dbx
+
+	return nil
+}
+
 // RowWriter return SQL table writer
 func (dd *SQLDataDestination) RowWriter(table push.Table) (push.RowWriter, *push.Error) {
 	rw, ok := dd.rowWriter[table.Name()]
@@ -218,6 +262,10 @@ type SQLRowWriter struct {
 	headers             ValueHeaders
 	disabledConstraints []SQLConstraint
 	sqlLogger           *SQLLoggerWriter
+	bulkRows            [][]interface{}
+	keyStatement        *sql.Stmt
+	keyHeaders          ValueHeaders
+	keyMode             GeneratedKeyMode
 }
 
 // NewSQLRowWriter creates a new SQL row writer.
@@ -260,19 +308,31 @@ func (rw *SQLRowWriter) close() *push.Error {
 		rw.statement = nil
 		log.Debug().Msg(fmt.Sprintf("close statement %s", rw.dd.mode))
 	}
+	if rw.keyStatement != nil {
+		err := rw.keyStatement.Close()
+		if err != nil {
+			return &push.Error{Description: err.Error()}
+		}
+		rw.keyStatement = nil
+	}
 	rw.sqlLogger.Close()
 	return nil
 }
 
 // build table name with or without schema from dataconnector
 func (rw *SQLRowWriter) tableName() string {
-	if rw.dd.schema == "" {
-		return rw.table.Name()
+	return qualifiedTableName(rw.dd.schema, rw.table)
+}
+
+// qualifiedTableName prefixes the table name with the schema, unless the name already contains one
+func qualifiedTableName(schema string, table push.Table) string {
+	if schema == "" {
+		return table.Name()
 	}
-	if strings.Contains(rw.table.Name(), ".") {
-		return rw.table.Name()
+	if strings.Contains(table.Name(), ".") {
+		return table.Name()
 	}
-	return rw.dd.schema + "." + rw.table.Name()
+	return schema + "." + table.Name()
 }
 
 func (rw *SQLRowWriter) createStatement(row push.Row, where push.Row) *push.Error {
@@ -282,51 +342,68 @@ func (rw *SQLRowWriter) createStatement(row push.Row, where push.Row) *push.Erro
 
 	selectValues, whereValues := rw.computeStatementInfos(row, where)
 
-	var prepareStmt string
-	var pusherr *push.Error
-
 	log.Debug().Msg(fmt.Sprintf("received mode %s", rw.dd.mode))
 
-	switch rw.dd.mode {
+	whereClause := ""
+	if rw.tableName() == rw.dd.startTableName {
+		whereClause = rw.dd.whereClause
+	}
+
+	prepareStmt, headers, pusherr := buildStatement(rw.dd.dialect, rw.dd.mode, rw.tableName(), selectValues, whereValues, rw.table.PrimaryKey(), whereClause, rw.dd.conflict, rw.table.Version())
+	if pusherr != nil {
+		return pusherr
+	}
+	rw.headers = headers
+
+	log.Debug().Stringer("headers", rw.headers).Msg(prepareStmt)
+
+	stmt, err := rw.dd.tx.Prepare(prepareStmt)
+	if err != nil {
+		return rw.dd.writeError(err)
+	}
+	rw.statement = stmt
+	rw.sqlLogger = rw.dd.sqlLogger.OpenWriter(rw.table, prepareStmt)
+	return nil
+}
+
+// buildStatement generates the statement to write a row in tableName with the given mode
+func buildStatement(d SQLDialect, mode push.Mode, tableName string, selectValues []ValueDescriptor, whereValues []ValueDescriptor, primaryKeys []string, whereClause string, policy push.ConflictPolicy, version string) (statement string, headers []ValueDescriptor, err *push.Error) {
+	switch mode {
 	case push.Delete:
 		/* #nosec */
-		prepareStmt = "DELETE FROM " + rw.tableName() + " WHERE "
+		statement = "DELETE FROM " + tableName + " WHERE "
 		for i := 0; i < len(whereValues); i++ {
-			prepareStmt += whereValues[i].name + "=" + rw.dd.dialect.Placeholder(i+1)
+			statement += whereValues[i].name + "=" + d.Placeholder(i+1)
 			if i < len(whereValues)-1 {
-				prepareStmt += " and "
+				statement += " and "
 			}
 		}
-		rw.headers = whereValues
+		headers = whereValues
 
 	case push.Update:
-		prepareStmt, rw.headers, pusherr = rw.dd.dialect.UpdateStatement(rw.tableName(), selectValues, whereValues, rw.table.PrimaryKey())
-		if pusherr != nil {
-			return pusherr
+		statement, headers, err = d.UpdateStatement(tableName, selectValues, whereValues, primaryKeys)
+		if err != nil {
+			return "", nil, err
 		}
-		if rw.dd.whereClause != "" && rw.tableName() == rw.dd.startTableName {
-			prepareStmt += " AND (" + rw.dd.whereClause + ")"
+		if whereClause != "" {
+			statement += " AND (" + whereClause + ")"
 		}
 
-	case push.Upsert:
-		prepareStmt, rw.headers, pusherr = rw.dd.dialect.UpsertStatement(rw.tableName(), selectValues, whereValues, rw.table.PrimaryKey())
-		if pusherr != nil {
-			return pusherr
+	default: // Insert, Truncate, Upsert
+		switch policy.Resolve(mode) {
+		case push.ConflictSkip:
+			statement, headers = d.InsertStatement(tableName, selectValues, primaryKeys)
+		case push.ConflictError:
+			statement, headers = d.InsertStatement(tableName, selectValues, nil)
+		default:
+			statement, headers, err = d.UpsertStatement(tableName, selectValues, whereValues, primaryKeys, policy.Resolve(mode), version)
+			if err != nil {
+				return "", nil, err
+			}
 		}
-
-	default: // Insert:
-		prepareStmt, rw.headers = rw.dd.dialect.InsertStatement(rw.tableName(), selectValues, rw.table.PrimaryKey())
 	}
 
-	log.Debug().Stringer("headers", rw.headers).Msg(prepareStmt)
-
-	stmt, err := rw.dd.tx.Prepare(prepareStmt)
-	if err != nil {
-		return &push.Error{Description: err.Error()}
-	}
-	rw.statement = stmt
-	rw.sqlLogger = rw.dd.sqlLogger.OpenWriter(rw.table, prepareStmt)
-	return nil
+	return statement, headers, nil
 }
 
 type ValueDescriptor struct {
@@ -351,16 +428,21 @@ func (vh ValueHeaders) String() string {
 }
 
 func (rw *SQLRowWriter) computeStatementInfos(row push.Row, where push.Row) (selectValues []ValueDescriptor, whereValues []ValueDescriptor) {
-	for _, pk := range rw.table.PrimaryKey() {
+	return statementInfos(rw.table, row, where)
+}
+
+// statementInfos describes the values of row to write and the values of primary keys to use in the where clause
+func statementInfos(table push.Table, row push.Row, where push.Row) (selectValues []ValueDescriptor, whereValues []ValueDescriptor) {
+	for _, pk := range table.PrimaryKey() {
 		if _, ok := where[pk]; ok {
-			whereValues = append(whereValues, ValueDescriptor{pk, true, rw.table.GetColumn(pk)})
+			whereValues = append(whereValues, ValueDescriptor{pk, true, table.GetColumn(pk)})
 		} else {
-			whereValues = append(whereValues, ValueDescriptor{pk, false, rw.table.GetColumn(pk)})
+			whereValues = append(whereValues, ValueDescriptor{pk, false, table.GetColumn(pk)})
 		}
 	}
 
 	for k := range row {
-		selectValues = append(selectValues, ValueDescriptor{k, false, rw.table.GetColumn(k)})
+		selectValues = append(selectValues, ValueDescriptor{k, false, table.GetColumn(k)})
 	}
 
 	return
@@ -368,6 +450,24 @@ func (rw *SQLRowWriter) computeStatementInfos(row push.Row, where push.Row) (sel
 
 // Write
 func (rw *SQLRowWriter) Write(row push.Row, where push.Row) *push.Error {
+	var entry push.Row
+	if rw.dd.journal != nil {
+		var err *push.Error
+		if entry, err = rw.prior(row, where); err != nil {
+			return err
+		}
+	}
+
+	if rw.dd.bulk {
+		if err := rw.buffer(row, where); err != nil {
+			return err
+		}
+		if entry != nil {
+			rw.dd.record(entry)
+		}
+		return nil
+	}
+
 	err1 := rw.createStatement(row, where)
 	if err1 != nil {
 		return err1
@@ -397,11 +497,16 @@ func (rw *SQLRowWriter) Write(row push.Row, where push.Row) *push.Error {
 		if err := rw.close(); err != nil {
 			return &push.Error{Description: err.Error() + "\noriginal error :\n" + err2.Error()}
 		}
-		if rw.dd.dialect.IsDuplicateError(err2) {
+		if rw.dd.dialect.IsDuplicateError(err2) && rw.dd.conflict.Resolve(rw.dd.mode) != push.ConflictError {
 			log.Trace().Msg(fmt.Sprintf("duplicate key %v (%s) for %s", row, rw.table.PrimaryKey(), rw.table.Name()))
 		} else {
-			return &push.Error{Description: err2.Error()}
+			return rw.dd.writeError(err2)
 		}
+		return nil
+	}
+
+	if entry != nil {
+		rw.dd.record(entry)
 	}
 
 	return nil
@@ -492,6 +597,66 @@ func isAPrimaryKey(columnName string, pkNames []string) bool {
 	return false
 }
 
+// conflictUpdates checks that policy can be applied and returns the columns to update on conflict, the version column last
+func conflictUpdates(tableName string, selectValues []ValueDescriptor, primaryKeys []string, policy push.ConflictPolicy, version string) ([]ValueDescriptor, *push.Error) {
+	switch policy {
+	case push.ConflictOverwrite, push.ConflictOverwriteNonNull:
+	case push.ConflictKeepNewer:
+		if version == "" {
+			return nil, &push.Error{Description: fmt.Sprintf("can't keep newer rows of table [%s] because no version column is defined in tables.yaml", tableName)}
+		}
+	default:
+		return nil, &push.Error{Description: fmt.Sprintf("can't upsert table [%s] with conflict policy %s", tableName, policy)}
+	}
+
+	updates := []ValueDescriptor{}
+	var versionValue *ValueDescriptor
+	for i, column := range selectValues {
+		switch {
+		case isAPrimaryKey(column.name, primaryKeys):
+		case column.name == version:
+			versionValue = &selectValues[i]
+		default:
+			updates = append(updates, column)
+		}
+	}
+	if versionValue != nil {
+		updates = append(updates, *versionValue)
+	}
+
+	return updates, nil
+}
+
+// conflictValue returns the expression of the new value of a column on conflict, source is the pushed value and target the existing one
+func conflictValue(policy push.ConflictPolicy, target string, source string) string {
+	if policy == push.ConflictOverwriteNonNull {
+		return "COALESCE(" + source + ", " + target + ")"
+	}
+	return source
+}
+
+// writeError describes an error returned by the database while writing rows
+func (dd *SQLDataDestination) writeError(err error) *push.Error {
+	code, constraint := dd.dialect.ErrorDetails(err)
+	return &push.Error{
+		Description: err.Error(),
+		Transient:   dd.dialect.IsTransientError(err),
+		Code:        code,
+		Constraint:  constraint,
+	}
+}
+
+// submatch returns the first group matched by re in s
+func submatch(re *regexp.Regexp, s string) string {
+	match := re.FindStringSubmatch(s)
+	for i := 1; i < len(match); i++ {
+		if match[i] != "" {
+			return match[i]
+		}
+	}
+	return ""
+}
+
 // SQLDialect is an interface to inject SQL variations
 type SQLDialect interface {
 	Placeholder(int) string
@@ -499,9 +664,14 @@ type SQLDialect interface {
 	EnableConstraintsStatement(tableName string) string
 	TruncateStatement(tableName string) string
 	InsertStatement(tableName string, selectValues []ValueDescriptor, primaryKeys []string) (statement string, headers []ValueDescriptor)
-	UpsertStatement(tableName string, selectValues []ValueDescriptor, whereValues []ValueDescriptor, primaryKeys []string) (statement string, headers []ValueDescriptor, err *push.Error)
+	// UpsertStatement generates an insert statement updating the existing row with policy (overwrite, overwrite-non-null or keep-newer) on conflict
+	UpsertStatement(tableName string, selectValues []ValueDescriptor, whereValues []ValueDescriptor, primaryKeys []string, policy push.ConflictPolicy, version string) (statement string, headers []ValueDescriptor, err *push.Error)
 	UpdateStatement(tableName string, selectValues []ValueDescriptor, whereValues []ValueDescriptor, primaryKeys []string) (statement string, headers []ValueDescriptor, err *push.Error)
 	IsDuplicateError(error) bool
+	// IsTransientError returns true if the statement can succeed when retried (deadlock, lock timeout, lost connection)
+	IsTransientError(error) bool
+	// ErrorDetails returns the error code (or SQLSTATE) and the name of the violated constraint, when available
+	ErrorDetails(error) (code string, constraint string)
 	ConvertValue(push.Value, ValueDescriptor) push.Value
 
 	CanDisableIndividualConstraints() bool
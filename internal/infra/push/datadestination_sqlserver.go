@@ -18,8 +18,14 @@
 package push
 
 import (
+	"database/sql"
+	"errors"
 	"fmt"
+	"io"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cgi-fr/lino/internal/infra/commonsql"
 	"github.com/cgi-fr/lino/pkg/push"
@@ -41,6 +47,20 @@ func (e *SQLServerDataDestinationFactory) New(url string, schema string) push.Da
 	return NewSQLDataDestination(url, schema, SQLServerDialect{innerDialect: commonsql.SQLServerDialect{}})
 }
 
+// NewScript return a SQLServer script writer
+func (e *SQLServerDataDestinationFactory) NewScript(script io.Writer, schema string) (push.DataDestination, *push.Error) {
+	dd, err := NewScriptDataDestination(script, schema, SQLServerDialect{innerDialect: commonsql.SQLServerDialect{}})
+	if err != nil {
+		return nil, err
+	}
+	return dd, nil
+}
+
+// NewDiff return a SQLServer datadestination comparing pushed rows with the database
+func (e *SQLServerDataDestinationFactory) NewDiff(url string, schema string, report push.RowWriter) push.DataDestination {
+	return NewSQLDiffDataDestination(url, schema, SQLServerDialect{innerDialect: commonsql.SQLServerDialect{}}, report)
+}
+
 // SQLServerDialect inject SQLServer variations
 type SQLServerDialect struct {
 	innerDialect commonsql.Dialect
@@ -101,8 +121,84 @@ func (d SQLServerDialect) InsertStatement(tableName string, selectValues []Value
 	return sql.String(), selectValues
 }
 
-func (d SQLServerDialect) UpsertStatement(tableName string, selectValues []ValueDescriptor, whereValues []ValueDescriptor, primaryKeys []string) (statement string, headers []ValueDescriptor, err *push.Error) {
-	return "", nil, &push.Error{Description: "upsert not implemented for sqlserver"}
+// InsertReturningStatement reads back the generated key with an OUTPUT clause
+func (d SQLServerDialect) InsertReturningStatement(tableName string, selectValues []ValueDescriptor, key string) (statement string, headers []ValueDescriptor, mode GeneratedKeyMode) {
+	statement, headers = d.InsertStatement(tableName, selectValues, nil)
+	statement = strings.Replace(statement, ") VALUES (", ") OUTPUT INSERTED."+d.Quote(key)+" VALUES (", 1)
+	return statement, headers, GeneratedKeyQuery
+}
+
+// UpsertStatement generates a MERGE statement applying policy
+func (d SQLServerDialect) UpsertStatement(tableName string, selectValues []ValueDescriptor, whereValues []ValueDescriptor, primaryKeys []string, policy push.ConflictPolicy, version string) (statement string, headers []ValueDescriptor, err *push.Error) {
+	if len(primaryKeys) == 0 {
+		return "", nil, &push.Error{Description: fmt.Sprintf("can't upsert table [%s] because no primary key is defined", tableName)}
+	}
+
+	updates, err := conflictUpdates(tableName, selectValues, primaryKeys, policy, version)
+	if err != nil {
+		return "", nil, err
+	}
+
+	schemaAndTable := strings.Split(tableName, ".")
+
+	sql := &strings.Builder{}
+	sql.WriteString("MERGE INTO ")
+	if len(schemaAndTable) == 1 {
+		sql.WriteString(d.innerDialect.Quote(schemaAndTable[0]))
+	} else {
+		sql.WriteString(d.innerDialect.Quote(schemaAndTable[0]) + "." + d.innerDialect.Quote(schemaAndTable[1]))
+	}
+	sql.WriteString(" AS target USING (SELECT ")
+	for i, column := range selectValues {
+		if i > 0 {
+			sql.WriteString(", ")
+		}
+		sql.WriteString(d.Placeholder(i + 1))
+		sql.WriteString(" AS ")
+		sql.WriteString(d.innerDialect.Quote(column.name))
+	}
+	sql.WriteString(") AS source ON (")
+	for i, pk := range primaryKeys {
+		if i > 0 {
+			sql.WriteString(" AND ")
+		}
+		sql.WriteString("target." + d.innerDialect.Quote(pk) + " = source." + d.innerDialect.Quote(pk))
+	}
+	sql.WriteString(")")
+
+	if len(updates) > 0 {
+		sql.WriteString(" WHEN MATCHED")
+		if policy == push.ConflictKeepNewer {
+			sql.WriteString(" AND source." + d.innerDialect.Quote(version) + " > target." + d.innerDialect.Quote(version))
+		}
+		sql.WriteString(" THEN UPDATE SET ")
+		for i, column := range updates {
+			if i > 0 {
+				sql.WriteString(", ")
+			}
+			quoted := d.innerDialect.Quote(column.name)
+			sql.WriteString("target." + quoted + " = " + conflictValue(policy, "target."+quoted, "source."+quoted))
+		}
+	}
+
+	sql.WriteString(" WHEN NOT MATCHED THEN INSERT (")
+	for i, column := range selectValues {
+		if i > 0 {
+			sql.WriteString(", ")
+		}
+		sql.WriteString(d.innerDialect.Quote(column.name))
+	}
+	sql.WriteString(") VALUES (")
+	for i, column := range selectValues {
+		if i > 0 {
+			sql.WriteString(", ")
+		}
+		sql.WriteString("source." + d.innerDialect.Quote(column.name))
+	}
+	// MERGE statements must be terminated by a semicolon
+	sql.WriteString(");")
+
+	return sql.String(), selectValues, nil
 }
 
 func (d SQLServerDialect) UpdateStatement(tableName string, selectValues []ValueDescriptor, whereValues []ValueDescriptor, primaryKeys []string) (statement string, headers []ValueDescriptor, err *push.Error) {
@@ -167,12 +263,69 @@ func (d SQLServerDialect) UpdateStatement(tableName string, selectValues []Value
 	return sql.String(), headers, nil
 }
 
+// BulkInsert loads rows with the bulk copy protocol
+func (d SQLServerDialect) BulkInsert(tx *sql.Tx, tableName string, headers []ValueDescriptor, primaryKeys []string, rows [][]interface{}) error {
+	columns := []string{}
+	for _, c := range headers {
+		columns = append(columns, c.name)
+	}
+
+	return copyIn(tx, mssql.CopyIn(tableName, mssql.BulkOptions{}, columns...), rows)
+}
+
+// SavepointStatement marks the point to roll back to when a bulk load fails
+func (d SQLServerDialect) SavepointStatement(name string) string {
+	return "SAVE TRANSACTION " + name
+}
+
+// RollbackToSavepointStatement cancels the statements executed since the savepoint
+func (d SQLServerDialect) RollbackToSavepointStatement(name string) string {
+	return "ROLLBACK TRANSACTION " + name
+}
+
 // IsDuplicateError check if error is a duplicate error
 func (d SQLServerDialect) IsDuplicateError(err error) bool {
 	msErr, ok := err.(mssql.Error)
 	return ok && msErr.Number == 2627 // Check violation number in https://github.com/microsoft/go-mssqldb/blob/main/error.go
 }
 
+// IsTransientError check if error is a deadlock, a lock timeout or a connection failure
+func (d SQLServerDialect) IsTransientError(err error) bool {
+	var msErr mssql.Error
+	if errors.As(err, &msErr) {
+		switch msErr.Number {
+		case 1205, 1222, 40197, 40501, 40613:
+			return true
+		}
+		return false
+	}
+	return isConnectionError(err)
+}
+
+// sqlServerConstraint matches the constraint name in SQL Server messages
+var sqlServerConstraint = regexp.MustCompile(`constraint ['"]([^'"]+)['"]`)
+
+// ErrorDetails returns the error number of a SQL Server error and the constraint found in its message
+func (d SQLServerDialect) ErrorDetails(err error) (string, string) {
+	var msErr mssql.Error
+	if errors.As(err, &msErr) {
+		return strconv.Itoa(int(msErr.Number)), submatch(sqlServerConstraint, msErr.Message)
+	}
+	return "", ""
+}
+
+// sqlServerLiterals renders literal values in SQL Server scripts
+var sqlServerLiterals = literalFormat{
+	bytes:     func(hexa string) string { return "0x" + hexa },
+	boolean:   numericBoolean,
+	timestamp: func(t time.Time) string { return "'" + t.Format("2006-01-02T15:04:05.999") + "'" },
+}
+
+// Literal renders value as a SQL literal
+func (d SQLServerDialect) Literal(value push.Value) string {
+	return sqlServerLiterals.literal(value)
+}
+
 // ConvertValue before load
 func (d SQLServerDialect) ConvertValue(from push.Value, descriptor ValueDescriptor) push.Value {
 	return from
@@ -0,0 +1,152 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cgi-fr/lino/internal/infra/commonsql"
+	"github.com/cgi-fr/lino/pkg/push"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpsertStatement(t *testing.T) {
+	t.Parallel()
+
+	values := []ValueDescriptor{{name: "id"}, {name: "updated"}, {name: "name"}}
+
+	tests := []struct {
+		name     string
+		dialect  SQLDialect
+		policy   push.ConflictPolicy
+		expected string
+	}{
+		{
+			"postgres keep-newer",
+			PostgresDialect{innerDialect: commonsql.PostgresDialect{}},
+			push.ConflictKeepNewer,
+			`INSERT INTO "customer" AS target("id","updated","name") VALUES ($1, $2, $3) ON CONFLICT (id) DO UPDATE SET "name" = EXCLUDED."name", "updated" = EXCLUDED."updated" WHERE EXCLUDED."updated" > target."updated"`,
+		},
+		{
+			"postgres overwrite-non-null",
+			PostgresDialect{innerDialect: commonsql.PostgresDialect{}},
+			push.ConflictOverwriteNonNull,
+			`INSERT INTO "customer" AS target("id","updated","name") VALUES ($1, $2, $3) ON CONFLICT (id) DO UPDATE SET "name" = COALESCE(EXCLUDED."name", target."name"), "updated" = COALESCE(EXCLUDED."updated", target."updated")`,
+		},
+		{
+			"oracle overwrite-non-null",
+			OracleDialect{innerDialect: commonsql.OracleDialect{}},
+			push.ConflictOverwriteNonNull,
+			`MERGE INTO "customer" target USING (SELECT :v1 AS "id", :v2 AS "updated", :v3 AS "name" FROM dual) source ON (target."id" = source."id") WHEN MATCHED THEN UPDATE SET target."name" = COALESCE(source."name", target."name"), target."updated" = COALESCE(source."updated", target."updated") WHEN NOT MATCHED THEN INSERT ("id", "updated", "name") VALUES (source."id", source."updated", source."name")`,
+		},
+		{
+			"sqlserver keep-newer",
+			SQLServerDialect{innerDialect: commonsql.SQLServerDialect{}},
+			push.ConflictKeepNewer,
+			`MERGE INTO [customer] AS target USING (SELECT @p1 AS [id], @p2 AS [updated], @p3 AS [name]) AS source ON (target.[id] = source.[id]) WHEN MATCHED AND source.[updated] > target.[updated] THEN UPDATE SET target.[name] = source.[name], target.[updated] = source.[updated] WHEN NOT MATCHED THEN INSERT ([id], [updated], [name]) VALUES (source.[id], source.[updated], source.[name]);`,
+		},
+		{
+			"mariadb overwrite",
+			MariadbDialect{innerDialect: commonsql.MariadbDialect{}},
+			push.ConflictOverwrite,
+			"INSERT INTO customer(`id`,`updated`,`name`) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE `name` = VALUES(`name`), `updated` = VALUES(`updated`)",
+		},
+		{
+			"mariadb keep-newer",
+			MariadbDialect{innerDialect: commonsql.MariadbDialect{}},
+			push.ConflictKeepNewer,
+			"INSERT INTO customer(`id`,`updated`,`name`) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE `name` = IF(VALUES(`updated`) > `updated`, VALUES(`name`), `name`), `updated` = IF(VALUES(`updated`) > `updated`, VALUES(`updated`), `updated`)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			statement, headers, err := tt.dialect.UpsertStatement("customer", values, nil, []string{"id"}, tt.policy, "updated")
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, statement)
+			assert.Equal(t, values, headers)
+		})
+	}
+}
+
+func TestUpsertStatementKeepNewerWithoutVersion(t *testing.T) {
+	t.Parallel()
+
+	d := PostgresDialect{innerDialect: commonsql.PostgresDialect{}}
+	_, _, err := d.UpsertStatement("customer", []ValueDescriptor{{name: "id"}, {name: "name"}}, nil, []string{"id"}, push.ConflictKeepNewer, "")
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "can't keep newer rows of table [customer] because no version column is defined in tables.yaml", err.Description)
+}
+
+func TestBuildStatementConflictPolicy(t *testing.T) {
+	t.Parallel()
+
+	d := PostgresDialect{innerDialect: commonsql.PostgresDialect{}}
+	values := []ValueDescriptor{{name: "id"}, {name: "name"}}
+
+	tests := []struct {
+		mode     push.Mode
+		policy   push.ConflictPolicy
+		expected string
+	}{
+		{push.Insert, push.ConflictDefault, `INSERT INTO "customer"("id","name") VALUES ($1, $2) ON CONFLICT (id) DO NOTHING`},
+		{push.Upsert, push.ConflictSkip, `INSERT INTO "customer"("id","name") VALUES ($1, $2) ON CONFLICT (id) DO NOTHING`},
+		{push.Insert, push.ConflictError, `INSERT INTO "customer"("id","name") VALUES ($1, $2)`},
+		{push.Upsert, push.ConflictDefault, `INSERT INTO "customer" AS target("id","name") VALUES ($1, $2) ON CONFLICT (id) DO UPDATE SET "name" = EXCLUDED."name"`},
+		{push.Truncate, push.ConflictOverwrite, `INSERT INTO "customer" AS target("id","name") VALUES ($1, $2) ON CONFLICT (id) DO UPDATE SET "name" = EXCLUDED."name"`},
+	}
+
+	for _, tt := range tests {
+		statement, _, err := buildStatement(d, tt.mode, "customer", values, nil, []string{"id"}, "", tt.policy, "")
+		assert.Nil(t, err)
+		assert.Equal(t, tt.expected, statement, "mode %s, policy %s", tt.mode, tt.policy)
+	}
+}
+
+func TestWriteConflictError(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	assert.Nil(t, err)
+	defer db.Close() //nolint:errcheck
+
+	mock.ExpectBegin()
+	mock.ExpectPrepare(`INSERT INTO "customer"\("id"\) VALUES \(\$1\)$`).
+		ExpectExec().WithArgs(1).WillReturnError(&pq.Error{Code: "23505", Constraint: "customer_pkey", Message: "duplicate key"})
+
+	tx, err := db.Begin()
+	assert.Nil(t, err)
+
+	dd := NewSQLDataDestination("", "", PostgresDialect{innerDialect: commonsql.PostgresDialect{}})
+	dd.tx = tx
+	dd.mode = push.Insert
+	dd.SetConflictPolicy(push.ConflictError)
+
+	rw := NewSQLRowWriter(push.NewTable("customer", []string{"id"}, nil), dd)
+	pusherr := rw.Write(push.Row{"id": 1}, nil)
+
+	assert.NotNil(t, pusherr)
+	assert.Equal(t, "23505", pusherr.Code)
+	assert.Equal(t, "customer_pkey", pusherr.Constraint)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
@@ -79,20 +79,20 @@ func (_m *MockSQLDialect) InsertStatement(tableName string, selectValues []Value
 	return r0, r1
 }
 
-// UpsertStatement provides a mock function with given fields: tableName, selectValues, whereValues, primaryKeys
-func (_m *MockSQLDialect) UpsertStatement(tableName string, selectValues []ValueDescriptor, whereValues []ValueDescriptor, primaryKeys []string) (string, []ValueDescriptor, *push.Error) {
-	ret := _m.Called(tableName, selectValues, whereValues, primaryKeys)
+// UpsertStatement provides a mock function with given fields: tableName, selectValues, whereValues, primaryKeys, policy, version
+func (_m *MockSQLDialect) UpsertStatement(tableName string, selectValues []ValueDescriptor, whereValues []ValueDescriptor, primaryKeys []string, policy push.ConflictPolicy, version string) (string, []ValueDescriptor, *push.Error) {
+	ret := _m.Called(tableName, selectValues, whereValues, primaryKeys, policy, version)
 
 	var r0 string
-	if rf, ok := ret.Get(0).(func(string, []ValueDescriptor, []ValueDescriptor, []string) string); ok {
-		r0 = rf(tableName, selectValues, whereValues, primaryKeys)
+	if rf, ok := ret.Get(0).(func(string, []ValueDescriptor, []ValueDescriptor, []string, push.ConflictPolicy, string) string); ok {
+		r0 = rf(tableName, selectValues, whereValues, primaryKeys, policy, version)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
 	var r1 []ValueDescriptor
-	if rf, ok := ret.Get(1).(func(string, []ValueDescriptor, []ValueDescriptor, []string) []ValueDescriptor); ok {
-		r1 = rf(tableName, selectValues, whereValues, primaryKeys)
+	if rf, ok := ret.Get(1).(func(string, []ValueDescriptor, []ValueDescriptor, []string, push.ConflictPolicy, string) []ValueDescriptor); ok {
+		r1 = rf(tableName, selectValues, whereValues, primaryKeys, policy, version)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).([]ValueDescriptor)
@@ -100,8 +100,8 @@ func (_m *MockSQLDialect) UpsertStatement(tableName string, selectValues []Value
 	}
 
 	var r2 *push.Error
-	if rf, ok := ret.Get(2).(func(string, []ValueDescriptor, []ValueDescriptor, []string) *push.Error); ok {
-		r2 = rf(tableName, selectValues, whereValues, primaryKeys)
+	if rf, ok := ret.Get(2).(func(string, []ValueDescriptor, []ValueDescriptor, []string, push.ConflictPolicy, string) *push.Error); ok {
+		r2 = rf(tableName, selectValues, whereValues, primaryKeys, policy, version)
 	} else {
 		if ret.Get(2) != nil {
 			r2 = ret.Get(2).(*push.Error)
@@ -157,6 +157,41 @@ func (_m *MockSQLDialect) IsDuplicateError(_a0 error) bool {
 	return r0
 }
 
+// ErrorDetails provides a mock function with given fields: _a0
+func (_m *MockSQLDialect) ErrorDetails(_a0 error) (string, string) {
+	ret := _m.Called(_a0)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(error) string); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(error) string); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	return r0, r1
+}
+
+// IsTransientError provides a mock function with given fields: _a0
+func (_m *MockSQLDialect) IsTransientError(_a0 error) bool {
+	ret := _m.Called(_a0)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(error) bool); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // Placeholder provides a mock function with given fields: _a0
 func (_m *MockSQLDialect) Placeholder(_a0 int) string {
 	ret := _m.Called(_a0)
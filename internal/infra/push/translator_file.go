@@ -18,15 +18,19 @@
 package push
 
 import (
+	"sync"
+
 	"github.com/cgi-fr/lino/pkg/push"
 )
 
 type FileTranslator struct {
-	caches map[push.Key]push.Cache
+	caches    map[push.Key]push.Cache
+	generated map[push.Key]push.Cache
+	mutex     sync.RWMutex
 }
 
 func NewFileTranslator() *FileTranslator {
-	return &FileTranslator{caches: map[push.Key]push.Cache{}}
+	return &FileTranslator{caches: map[push.Key]push.Cache{}, generated: map[push.Key]push.Cache{}}
 }
 
 func (ft *FileTranslator) Load(keys []push.Key, rows push.RowIterator) *push.Error {
@@ -52,3 +56,55 @@ func (ft *FileTranslator) FindValue(key push.Key, value push.Value) push.Value {
 	}
 	return value
 }
+
+func (ft *FileTranslator) Generate(key push.Key) {
+	ft.mutex.Lock()
+	defer ft.mutex.Unlock()
+
+	if _, exists := ft.generated[key]; !exists {
+		ft.generated[key] = push.Cache{}
+	}
+}
+
+func (ft *FileTranslator) IsGenerated(key push.Key) bool {
+	ft.mutex.RLock()
+	defer ft.mutex.RUnlock()
+
+	_, exists := ft.generated[key]
+	return exists
+}
+
+func (ft *FileTranslator) Record(key push.Key, value push.Value, generated push.Value) {
+	ft.mutex.Lock()
+	defer ft.mutex.Unlock()
+
+	if cache, exists := ft.generated[key]; exists {
+		cache[value] = generated
+	}
+}
+
+func (ft *FileTranslator) Generated(key push.Key, value push.Value) (push.Value, bool) {
+	ft.mutex.RLock()
+	defer ft.mutex.RUnlock()
+
+	if cache, exists := ft.generated[key]; exists {
+		generated, exists := cache[value]
+		return generated, exists
+	}
+	return nil, false
+}
+
+func (ft *FileTranslator) GeneratedKeys() map[push.Key]push.Cache {
+	ft.mutex.RLock()
+	defer ft.mutex.RUnlock()
+
+	result := make(map[push.Key]push.Cache, len(ft.generated))
+	for key, cache := range ft.generated {
+		copied := make(push.Cache, len(cache))
+		for value, generated := range cache {
+			copied[value] = generated
+		}
+		result[key] = copied
+	}
+	return result
+}
@@ -51,3 +51,21 @@ func TestConvertValueWithNilValueDescriptor(t *testing.T) {
 	err := oracleDialect.ConvertValue("value", descriptor)
 	assert.Equal(t, "value", err)
 }
+
+func TestTranslatorGeneratedKeys(t *testing.T) {
+	translator := push.NewFileTranslator()
+	key := driver.Key{TableName: "table1", ColumnName: "id"}
+
+	translator.Generate(key)
+	assert.True(t, translator.IsGenerated(key))
+	assert.False(t, translator.IsGenerated(driver.Key{TableName: "table2", ColumnName: "id"}))
+
+	_, exists := translator.Generated(key, 1)
+	assert.False(t, exists)
+
+	translator.Record(key, 1, int64(1001))
+	generated, exists := translator.Generated(key, 1)
+	assert.True(t, exists)
+	assert.Equal(t, int64(1001), generated)
+	assert.Equal(t, map[driver.Key]driver.Cache{key: {1: int64(1001)}}, translator.GeneratedKeys())
+}
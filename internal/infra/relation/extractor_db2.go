@@ -38,28 +38,39 @@ type Db2ExtractorFactory struct{
 
 // New return a Db2 extractor
 func (e *Db2ExtractorFactory) New(url string, schema string) relation.Extractor {
-	return NewSQLExtractor(url, schema, Db2Dialect{})
+	return NewSQLExtractor(url, []string{schema}, Db2Dialect{})
+}
+
+// NewMultiSchema return a Db2 extractor reading relations across several schemas
+func (e *Db2ExtractorFactory) NewMultiSchema(url string, schemas []string) relation.Extractor {
+	return NewSQLExtractor(url, schemas, Db2Dialect{})
 }
 
 type Db2Dialect struct{}
 
-func (d Db2Dialect) SQL(schema string) string {
+func (d Db2Dialect) SQL(schemas []string) string {
 	SQL := `
 	select
 		ref.constname as constraint_name,
-		ref.reftabname as primary_table,
-		ref.refkeyname as primary_key,
+		ref.tabschema as foreign_schema,
 		ref.tabname as foreign_table,
-		LISTAGG(k.colname, ',') AS foreign_key_names
+		fk.colname as foreign_key_name,
+		ref.reftabschema as primary_schema,
+		ref.reftabname as primary_table,
+		pk.colname as primary_key_name
 	from syscat.references ref
-	inner join syscat.keycoluse k
-		on ref.constname = k.constname
+	inner join syscat.keycoluse fk
+		on fk.constname = ref.constname and fk.tabschema = ref.tabschema and fk.tabname = ref.tabname
+	inner join syscat.keycoluse pk
+		on pk.constname = ref.refkeyname and pk.tabschema = ref.reftabschema and pk.tabname = ref.reftabname and pk.colseq = fk.colseq
 	where ref.ownertype = 'U'
-	group by ref.constname, ref.reftabname, ref.refkeyname, ref.tabname
   `
 
-	if schema != "" {
-		SQL += fmt.Sprintf(" and ref.tabschema = '%s' and ref.reftabschema = '%s'", schema, schema)
+	if len(schemas) > 0 {
+		SQL += fmt.Sprintf(" and ref.tabschema in (%s)", inList(schemas))
 	}
+
+	SQL += " order by ref.tabschema, ref.tabname, ref.constname, fk.colseq"
+
 	return SQL
 }
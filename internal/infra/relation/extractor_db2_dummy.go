@@ -36,11 +36,16 @@ type Db2ExtractorFactory struct{
 
 // New return a Db2 extractor
 func (e *Db2ExtractorFactory) New(url string, schema string) relation.Extractor {
-	return NewSQLExtractor(url, schema, Db2Dialect{})
+	return NewSQLExtractor(url, []string{schema}, Db2Dialect{})
+}
+
+// NewMultiSchema return a Db2 extractor reading relations across several schemas
+func (e *Db2ExtractorFactory) NewMultiSchema(url string, schemas []string) relation.Extractor {
+	return NewSQLExtractor(url, schemas, Db2Dialect{})
 }
 
 type Db2Dialect struct{}
 
-func (d Db2Dialect) SQL(schema string) string {
+func (d Db2Dialect) SQL(schemas []string) string {
 	panic(fmt.Errorf("not implemented"))
 }
@@ -37,29 +37,36 @@ type MariadbExtractorFactory struct{
 
 // New return a Mariadb extractor
 func (e *MariadbExtractorFactory) New(url string, schema string) relation.Extractor {
-	return NewSQLExtractor(url, schema, MariadbDialect{})
+	return NewSQLExtractor(url, []string{schema}, MariadbDialect{})
+}
+
+// NewMultiSchema return a Mariadb extractor reading relations across several schemas
+func (e *MariadbExtractorFactory) NewMultiSchema(url string, schemas []string) relation.Extractor {
+	return NewSQLExtractor(url, schemas, MariadbDialect{})
 }
 
 type MariadbDialect struct{}
 
-func (d MariadbDialect) SQL(schema string) string {
+func (d MariadbDialect) SQL(schemas []string) string {
 	SQL := `
 SELECT
-    tc.constraint_name,
-    tc.table_name,
+    kcu.constraint_name,
+    kcu.table_schema,
+    kcu.table_name,
     kcu.column_name,
+    kcu.referenced_table_schema AS foreign_table_schema,
     kcu.referenced_table_name AS foreign_table_name,
     kcu.referenced_column_name AS foreign_column_name
 FROM
-    information_schema.table_constraints AS tc
-    JOIN information_schema.key_column_usage AS kcu
-      ON tc.constraint_name = kcu.constraint_name
-      AND tc.table_schema = kcu.table_schema
-WHERE tc.constraint_type = 'FOREIGN KEY'
-            `
+    information_schema.key_column_usage AS kcu
+WHERE kcu.referenced_table_name IS NOT NULL
+`
 
-	if schema != "" {
-		SQL += fmt.Sprintf("AND tc.table_schema = '%s'", schema)
+	if len(schemas) > 0 {
+		SQL += fmt.Sprintf("AND kcu.table_schema IN (%s)\n", inList(schemas))
 	}
+
+	SQL += "ORDER BY kcu.table_schema, kcu.table_name, kcu.constraint_name, kcu.ordinal_position"
+
 	return SQL
 }
@@ -1,8 +1,6 @@
 package relation
 
 import (
-	"fmt"
-
 	"github.com/cgi-fr/lino/pkg/relation"
 
 	// import Oracle connector
@@ -19,48 +17,52 @@ type OracleExtractorFactory struct{
 
 // New return a Oracle extractor
 func (e *OracleExtractorFactory) New(url string, schema string) relation.Extractor {
-	return NewSQLExtractor(url, schema, OracleDialect{})
+	return NewSQLExtractor(url, []string{schema}, OracleDialect{})
+}
+
+// NewMultiSchema return a Oracle extractor reading relations across several schemas
+func (e *OracleExtractorFactory) NewMultiSchema(url string, schemas []string) relation.Extractor {
+	return NewSQLExtractor(url, schemas, OracleDialect{})
 }
 
 type OracleDialect struct{}
 
-func (d OracleDialect) SQL(schema string) string {
-	if schema == "" {
-		schema = "user"
-	} else {
-		schema = fmt.Sprintf("'%s'", schema)
+func (d OracleDialect) SQL(schemas []string) string {
+	owners := "user"
+	if len(schemas) > 0 {
+		owners = inList(schemas)
 	}
 
 	SQL := `
 SELECT
     fk.constraint_name    AS relation_name,
+    fk.owner              AS child_schema,
     fk.table_name         AS child_table,
     fk_cols.column_name   AS child_column,
+    pk.owner              AS parent_schema,
     pk.table_name         AS parent_table,
     pk_cols.column_name   AS parent_column
 FROM
     all_constraints fk
--- 1. Récupérer le parent (en précisant le R_OWNER car le parent peut être dans un autre schéma)
+-- the parent can be in another schema, join on R_OWNER
 JOIN
-    all_constraints pk 
-    ON fk.r_constraint_name = pk.constraint_name 
+    all_constraints pk
+    ON fk.r_constraint_name = pk.constraint_name
     AND fk.r_owner = pk.owner
--- 2. Colonnes de l'enfant (Jointure sur NOM + OWNER)
 JOIN
-    all_cons_columns fk_cols 
-    ON fk.constraint_name = fk_cols.constraint_name 
+    all_cons_columns fk_cols
+    ON fk.constraint_name = fk_cols.constraint_name
     AND fk.owner = fk_cols.owner
--- 3. Colonnes du parent (Jointure sur NOM + OWNER)
 JOIN
-    all_cons_columns pk_cols 
-    ON pk.constraint_name = pk_cols.constraint_name 
+    all_cons_columns pk_cols
+    ON pk.constraint_name = pk_cols.constraint_name
     AND pk.owner = pk_cols.owner
+    AND fk_cols.position = pk_cols.position
 WHERE
     fk.constraint_type = 'R' -- foreignkey
-    AND fk_cols.position = pk_cols.position
-    -- FILTRE SUR LE PROPRIÉTAIRE ICI :
-    AND fk.owner = ` + schema + `
+    AND fk.owner IN (` + owners + `)
 ORDER BY
+    fk.owner,
     fk.table_name,
     fk.constraint_name,
     fk_cols.position
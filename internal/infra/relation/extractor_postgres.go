@@ -38,33 +38,43 @@ type PostgresExtractorFactory struct{
 
 // New return a Postgres extractor
 func (e *PostgresExtractorFactory) New(url string, schema string) relation.Extractor {
-	return NewSQLExtractor(url, schema, PostgresDialect{})
+	return NewSQLExtractor(url, []string{schema}, PostgresDialect{})
+}
+
+// NewMultiSchema return a Postgres extractor reading relations across several schemas
+func (e *PostgresExtractorFactory) NewMultiSchema(url string, schemas []string) relation.Extractor {
+	return NewSQLExtractor(url, schemas, PostgresDialect{})
 }
 
 type PostgresDialect struct{}
 
-func (d PostgresDialect) SQL(schema string) string {
+func (d PostgresDialect) SQL(schemas []string) string {
 	SQL := `
 SELECT
-    tc.constraint_name,
-    tc.table_name,
-    kcu.column_name,
-    ccu.table_name AS foreign_table_name,
-    ccu.column_name AS foreign_column_name
+    con.conname,
+    cns.nspname AS table_schema,
+    cl.relname AS table_name,
+    ca.attname AS column_name,
+    pns.nspname AS foreign_table_schema,
+    pl.relname AS foreign_table_name,
+    pa.attname AS foreign_column_name
 FROM
-    information_schema.table_constraints AS tc
-    JOIN information_schema.key_column_usage AS kcu
-      ON tc.constraint_name = kcu.constraint_name
-      AND tc.table_schema = kcu.table_schema
-    JOIN information_schema.constraint_column_usage AS ccu
-      ON ccu.constraint_name = tc.constraint_name
-      AND ccu.table_schema = tc.table_schema
-WHERE tc.constraint_type = 'FOREIGN KEY'
-            `
+    pg_catalog.pg_constraint con
+    CROSS JOIN LATERAL unnest(con.conkey, con.confkey) WITH ORDINALITY AS k(attnum, foreign_attnum, position)
+    JOIN pg_catalog.pg_class cl ON cl.oid = con.conrelid
+    JOIN pg_catalog.pg_namespace cns ON cns.oid = cl.relnamespace
+    JOIN pg_catalog.pg_attribute ca ON ca.attrelid = con.conrelid AND ca.attnum = k.attnum
+    JOIN pg_catalog.pg_class pl ON pl.oid = con.confrelid
+    JOIN pg_catalog.pg_namespace pns ON pns.oid = pl.relnamespace
+    JOIN pg_catalog.pg_attribute pa ON pa.attrelid = con.confrelid AND pa.attnum = k.foreign_attnum
+WHERE con.contype = 'f'
+`
 
-	if schema != "" {
-		SQL += fmt.Sprintf("AND tc.table_schema = '%s'", schema)
+	if len(schemas) > 0 {
+		SQL += fmt.Sprintf("AND cns.nspname IN (%s)\n", inList(schemas))
 	}
 
+	SQL += "ORDER BY cns.nspname, cl.relname, con.conname, k.position"
+
 	return SQL
 }
@@ -18,6 +18,9 @@
 package relation
 
 import (
+	"database/sql"
+	"strings"
+
 	"github.com/cgi-fr/lino/pkg/relation"
 	"github.com/rs/zerolog/log"
 	"github.com/xo/dburl"
@@ -26,19 +29,24 @@
 // SQLExtractor provides relation extraction logic from SQL database.
 type SQLExtractor struct {
 	url     string
-	schema  string
+	schemas []string
 	dialect Dialect
 }
 
+// Dialect gives the SQL query reading foreign keys, with one row per column of a key and the columns :
+// relation name, child schema, child table, child column, parent schema, parent table, parent column.
+// Rows are ordered by child schema, child table, relation name and position of the column in the key.
 type Dialect interface {
-	SQL(schema string) string
+	// SQL reads the foreign keys of tables in the schemas, or of all tables if schemas is empty
+	SQL(schemas []string) string
 }
 
-// NewSQLExtractor creates a new SQL extractor.
-func NewSQLExtractor(url string, schema string, dialect Dialect) *SQLExtractor {
+// NewSQLExtractor creates a new SQL extractor, the first schema is the default schema of the dataconnector and the
+// other schemas hold tables related to the tables of the default schema.
+func NewSQLExtractor(url string, schemas []string, dialect Dialect) *SQLExtractor {
 	return &SQLExtractor{
 		url:     url,
-		schema:  schema,
+		schemas: schemas,
 		dialect: dialect,
 	}
 }
@@ -56,43 +64,60 @@ func (e *SQLExtractor) Extract() ([]relation.Relation, *relation.Error) {
 		return nil, &relation.Error{Description: err.Error()}
 	}
 
-	sql := e.dialect.SQL(e.schema)
+	return e.read(db)
+}
+
+// read the relations from the foreign keys, grouping the columns of composite keys.
+func (e *SQLExtractor) read(db *sql.DB) ([]relation.Relation, *relation.Error) {
+	query := e.dialect.SQL(e.filter())
 
-	log.Debug().Msgf("Executing relation extraction SQL:\n%s", sql)
+	log.Debug().Msgf("Executing relation extraction SQL:\n%s", query)
 
-	rows, err := db.Query(sql)
+	rows, err := db.Query(query)
 	if err != nil {
 		return nil, &relation.Error{Description: err.Error()}
 	}
+	defer rows.Close() //nolint:errcheck
 
 	relations := []relation.Relation{}
 
 	var (
 		relationName string
+		sourceSchema string
 		sourceTable  string
 		sourceColumn string
+		targetSchema string
 		targetTable  string
 		targetColumn string
 	)
 
+	currentKey := ""
 	currentRelation := relation.Relation{}
 
 	for rows.Next() {
-		err := rows.Scan(&relationName, &sourceTable, &sourceColumn, &targetTable, &targetColumn)
+		err := rows.Scan(&relationName, &sourceSchema, &sourceTable, &sourceColumn, &targetSchema, &targetTable, &targetColumn)
 		if err != nil {
 			return nil, &relation.Error{Description: err.Error()}
 		}
 
-		if currentRelation.Name != relationName {
+		relationName = strings.TrimSpace(relationName)
+		sourceSchema, sourceTable, sourceColumn = strings.TrimSpace(sourceSchema), strings.TrimSpace(sourceTable), strings.TrimSpace(sourceColumn)
+		targetSchema, targetTable, targetColumn = strings.TrimSpace(targetSchema), strings.TrimSpace(targetTable), strings.TrimSpace(targetColumn)
+
+		// constraint names are only unique by table
+		key := sourceSchema + "." + sourceTable + "." + relationName
+
+		if currentKey != key {
 			relations = append(relations, currentRelation)
+			currentKey = key
 			currentRelation = relation.Relation{
 				Name: relationName,
 				Parent: relation.Table{
-					Name: targetTable,
+					Name: e.qualify(targetSchema, targetTable, sourceSchema),
 					Keys: []string{targetColumn},
 				},
 				Child: relation.Table{
-					Name: sourceTable,
+					Name: e.qualify(sourceSchema, sourceTable, targetSchema),
 					Keys: []string{sourceColumn},
 				},
 			}
@@ -110,3 +135,36 @@ func (e *SQLExtractor) Extract() ([]relation.Relation, *relation.Error) {
 
 	return relations[1:], nil
 }
+
+// filter returns the schemas to read, or nil to read all schemas if the dataconnector has no default schema.
+func (e *SQLExtractor) filter() []string {
+	if len(e.schemas) == 0 || e.schemas[0] == "" {
+		return nil
+	}
+	return e.schemas
+}
+
+// qualify prefixes the table name with its schema, unless it is the default schema of the dataconnector.
+// Without default schema, the names are only qualified if the relation crosses schemas.
+func (e *SQLExtractor) qualify(schema string, table string, otherSchema string) string {
+	if defaults := e.filter(); defaults != nil {
+		if schema == defaults[0] {
+			return table
+		}
+		return schema + "." + table
+	}
+
+	if schema == otherSchema || schema == "" {
+		return table
+	}
+	return schema + "." + table
+}
+
+// inList formats the schemas as a list of SQL strings.
+func inList(schemas []string) string {
+	quoted := make([]string, len(schemas))
+	for i, schema := range schemas {
+		quoted[i] = "'" + strings.ReplaceAll(schema, "'", "''") + "'"
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,65 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package relation
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cgi-fr/lino/pkg/relation"
+	"github.com/stretchr/testify/assert"
+)
+
+type staticDialect struct{}
+
+func (staticDialect) SQL(schemas []string) string {
+	return "SELECT FK " + inList(schemas)
+}
+
+func TestExtractCompositeAndCrossSchema(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+
+	columns := []string{"name", "child_schema", "child_table", "child_column", "parent_schema", "parent_table", "parent_column"}
+	mock.ExpectQuery(`SELECT FK 'public', 'sales'`).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow("line_order_fk", "public", "line", "order_id", "public", "orders", "id").
+			AddRow("line_order_fk", "public", "line", "order_year", "public", "orders", "year").
+			AddRow("orders_customer_fk", "public", "orders", "customer_id", "sales ", "customer", "id"))
+
+	extractor := NewSQLExtractor("", []string{"public", "sales"}, staticDialect{})
+
+	relations, e := extractor.read(db)
+
+	assert.Nil(t, e)
+	assert.Equal(t, []relation.Relation{
+		{
+			Name:   "line_order_fk",
+			Parent: relation.Table{Name: "orders", Keys: []string{"id", "year"}},
+			Child:  relation.Table{Name: "line", Keys: []string{"order_id", "order_year"}},
+		},
+		{
+			Name:   "orders_customer_fk",
+			Parent: relation.Table{Name: "sales.customer", Keys: []string{"id"}},
+			Child:  relation.Table{Name: "orders", Keys: []string{"customer_id"}},
+		},
+	}, relations)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
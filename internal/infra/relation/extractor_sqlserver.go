@@ -35,68 +35,45 @@ type SQLServerExtractorFactory struct{
 
 // New return a SQL Server extractor
 func (e *SQLServerExtractorFactory) New(connectionString string, schema string) relation.Extractor {
-	return NewSQLExtractor(connectionString, schema, SQLServerDialect{})
+	return NewSQLExtractor(connectionString, []string{schema}, SQLServerDialect{})
+}
+
+// NewMultiSchema return a SQL Server extractor reading relations across several schemas
+func (e *SQLServerExtractorFactory) NewMultiSchema(connectionString string, schemas []string) relation.Extractor {
+	return NewSQLExtractor(connectionString, schemas, SQLServerDialect{})
 }
 
 type SQLServerDialect struct{}
 
-func (d SQLServerDialect) SQL(schema string) string {
+func (d SQLServerDialect) SQL(schemas []string) string {
 	SQL := `
 	SELECT
-		'FK__' + TP2.name + '__' + TP.name + '__' + CONVERT(VARCHAR(40), FK.object_id) AS name,
+		'FK__' + parent.name + '__' + child.name + '__' + CONVERT(VARCHAR(40), FK.object_id) AS name,
+		SCHEMA_NAME(child.schema_id) AS child_schema,
 		child.name AS child_name,
-		child.keys,
+		child_column.name AS child_column,
+		SCHEMA_NAME(parent.schema_id) AS parent_schema,
 		parent.name AS parent_name,
-		parent.keys
+		parent_column.name AS parent_column
 	FROM
 		sys.foreign_keys FK
 	JOIN
-		sys.tables TP ON FK.parent_object_id = TP.object_id
+		sys.foreign_key_columns FKC ON FKC.constraint_object_id = FK.object_id
 	JOIN
-		sys.tables TP2 ON FK.referenced_object_id = TP2.object_id
+		sys.tables child ON FK.parent_object_id = child.object_id
 	JOIN
-		(
-			SELECT
-				FK.name AS constraint_name,
-				TP2.name AS name,
-				STRING_AGG(ccu.column_name, ',') AS keys
-			FROM
-				sys.foreign_keys FK
-			JOIN
-				sys.tables TP2 ON FK.referenced_object_id = TP2.object_id
-			JOIN
-				information_schema.constraint_column_usage AS ccu
-				ON FK.name = ccu.constraint_name
-			WHERE
-				FK.type = 'F'
-			GROUP BY
-				FK.name, TP2.name
-		) parent
-		ON FK.name = parent.constraint_name AND TP2.name = parent.name
+		sys.columns child_column ON child_column.object_id = FKC.parent_object_id AND child_column.column_id = FKC.parent_column_id
 	JOIN
-		(
-			SELECT
-				FK.name AS constraint_name,
-				TP.name AS name,
-				STRING_AGG(kcu.column_name, ',') AS keys
-			FROM
-				sys.foreign_keys FK
-			JOIN
-				sys.tables TP ON FK.parent_object_id = TP.object_id
-			JOIN
-				information_schema.key_column_usage AS kcu
-				ON FK.name = kcu.constraint_name
-			WHERE
-				FK.type = 'F'
-			GROUP BY
-				FK.name, TP.name
-		) child
-		ON FK.name = child.constraint_name AND TP.name = child.name;
-
+		sys.tables parent ON FK.referenced_object_id = parent.object_id
+	JOIN
+		sys.columns parent_column ON parent_column.object_id = FKC.referenced_object_id AND parent_column.column_id = FKC.referenced_column_id
 `
 
-	if schema != "" {
-		SQL += fmt.Sprintf("AND tc.table_schema = '%s'", schema)
+	if len(schemas) > 0 {
+		SQL += fmt.Sprintf("\tWHERE SCHEMA_NAME(child.schema_id) IN (%s)\n", inList(schemas))
 	}
+
+	SQL += "\tORDER BY SCHEMA_NAME(child.schema_id), child.name, FK.name, FKC.constraint_column_id"
+
 	return SQL
 }
@@ -0,0 +1,156 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package relation
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/cgi-fr/lino/internal/infra/commonsql"
+	"github.com/cgi-fr/lino/pkg/relation"
+	"github.com/rs/zerolog/log"
+	"github.com/xo/dburl"
+)
+
+// SQLInclusionCheckerFactory exposes methods to create new SQL inclusion checkers.
+type SQLInclusionCheckerFactory struct {
+	dialect commonsql.Dialect
+}
+
+// NewSQLInclusionCheckerFactory creates a new SQL inclusion checker factory.
+func NewSQLInclusionCheckerFactory(dialect commonsql.Dialect) *SQLInclusionCheckerFactory {
+	return &SQLInclusionCheckerFactory{dialect: dialect}
+}
+
+// New return a SQL inclusion checker
+func (f *SQLInclusionCheckerFactory) New(url string, schema string) relation.InclusionChecker {
+	return &SQLInclusionChecker{
+		url:     url,
+		schema:  schema,
+		dialect: f.dialect,
+	}
+}
+
+// SQLInclusionChecker samples distinct values of a child column and counts them in the parent key column.
+type SQLInclusionChecker struct {
+	url     string
+	schema  string
+	dialect commonsql.Dialect
+	db      *sql.DB
+}
+
+// Check implements relation.InclusionChecker, only single column keys are supported.
+func (c *SQLInclusionChecker) Check(parent relation.Table, child relation.Table, sampleSize uint) (int, int, *relation.Error) {
+	if len(parent.Keys) != 1 || len(child.Keys) != 1 {
+		return 0, 0, &relation.Error{Description: "inclusion check is only available on single column keys"}
+	}
+
+	if err := c.open(); err != nil {
+		return 0, 0, err
+	}
+
+	childTable, childSchema := c.split(child.Name)
+	column := c.dialect.Quote(child.Keys[0])
+
+	query := c.dialect.SelectLimit(childTable, childSchema, column+" IS NOT NULL", true, nil, sampleSize, commonsql.ColumnExportDefinition{Name: child.Keys[0]})
+
+	log.Debug().Msgf("Executing inclusion sampling SQL:\n%s", query)
+
+	rows, err := c.db.Query(query)
+	if err != nil {
+		return 0, 0, &relation.Error{Description: err.Error()}
+	}
+	defer rows.Close() //nolint:errcheck
+
+	values := []interface{}{}
+	for rows.Next() {
+		var value interface{}
+		if err := rows.Scan(&value); err != nil {
+			return 0, 0, &relation.Error{Description: err.Error()}
+		}
+		values = append(values, value)
+	}
+
+	if err := rows.Err(); err != nil {
+		return 0, 0, &relation.Error{Description: err.Error()}
+	}
+
+	if len(values) == 0 {
+		return 0, 0, nil
+	}
+
+	placeholders := make([]string, len(values))
+	for i := range values {
+		placeholders[i] = c.dialect.Placeholder(i + 1)
+	}
+
+	parentTable, parentSchema := c.split(parent.Name)
+	key := c.dialect.Quote(parent.Keys[0])
+
+	query = fmt.Sprintf("SELECT COUNT(DISTINCT %s) %s %s", key, c.dialect.From(parentTable, parentSchema),
+		c.dialect.Where(fmt.Sprintf("%s IN (%s)", key, strings.Join(placeholders, ", "))))
+
+	log.Debug().Msgf("Executing inclusion check SQL:\n%s", query)
+
+	var found int
+	if err := c.db.QueryRow(query, values...).Scan(&found); err != nil {
+		return 0, 0, &relation.Error{Description: err.Error()}
+	}
+
+	return len(values), found, nil
+}
+
+// Close the connection to the database.
+func (c *SQLInclusionChecker) Close() *relation.Error {
+	if c.db == nil {
+		return nil
+	}
+	if err := c.db.Close(); err != nil {
+		return &relation.Error{Description: err.Error()}
+	}
+	return nil
+}
+
+func (c *SQLInclusionChecker) open() *relation.Error {
+	if c.db != nil {
+		return nil
+	}
+
+	db, err := dburl.Open(c.url)
+	if err != nil {
+		return &relation.Error{Description: err.Error()}
+	}
+
+	if err := db.Ping(); err != nil {
+		db.Close() //nolint:errcheck
+		return &relation.Error{Description: err.Error()}
+	}
+
+	c.db = db
+
+	return nil
+}
+
+// split returns the table name and its schema, the schema of the dataconnector is used if the name is not qualified.
+func (c *SQLInclusionChecker) split(name string) (string, string) {
+	if i := strings.LastIndex(name, "."); i > 0 {
+		return name[i+1:], name[:i]
+	}
+	return name, c.schema
+}
@@ -0,0 +1,55 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package relation
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cgi-fr/lino/internal/infra/commonsql"
+	"github.com/cgi-fr/lino/pkg/relation"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInclusionCheck(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+
+	mock.ExpectQuery(`SELECT DISTINCT "customer_id" FROM "public"."orders" WHERE "customer_id" IS NOT NULL LIMIT 3`).
+		WillReturnRows(sqlmock.NewRows([]string{"customer_id"}).AddRow(1).AddRow(2).AddRow(3))
+	mock.ExpectQuery(`SELECT COUNT(DISTINCT "id") FROM "sales"."customer" WHERE "id" IN ($1, $2, $3)`).
+		WithArgs(1, 2, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+	mock.ExpectClose()
+
+	checker := &SQLInclusionChecker{schema: "public", dialect: commonsql.PostgresDialect{}, db: db}
+
+	sampled, found, e := checker.Check(
+		relation.Table{Name: "sales.customer", Keys: []string{"id"}},
+		relation.Table{Name: "orders", Keys: []string{"customer_id"}},
+		3,
+	)
+
+	assert.Nil(t, e)
+	assert.Equal(t, 3, sampled)
+	assert.Equal(t, 2, found)
+	assert.Nil(t, checker.Close())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -9,13 +9,13 @@ type MockDialect struct {
 	mock.Mock
 }
 
-// SQL provides a mock function with given fields: schema
-func (_m *MockDialect) SQL(schema string) string {
-	ret := _m.Called(schema)
+// SQL provides a mock function with given fields: schemas
+func (_m *MockDialect) SQL(schemas []string) string {
+	ret := _m.Called(schemas)
 
 	var r0 string
-	if rf, ok := ret.Get(0).(func(string) string); ok {
-		r0 = rf(schema)
+	if rf, ok := ret.Get(0).(func([]string) string); ok {
+		r0 = rf(schemas)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
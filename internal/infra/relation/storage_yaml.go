@@ -36,9 +36,10 @@ type YAMLStructure struct {
 
 // YAMLRelation defines how to store a relation in YAML format.
 type YAMLRelation struct {
-	Name   string    `yaml:"name"`
-	Parent YAMLTable `yaml:"parent"`
-	Child  YAMLTable `yaml:"child"`
+	Name    string    `yaml:"name"`
+	Parent  YAMLTable `yaml:"parent"`
+	Child   YAMLTable `yaml:"child"`
+	Virtual bool      `yaml:"virtual,omitempty"`
 }
 
 // YAMLTable defines how to store a relation in YAML format.
@@ -74,6 +75,7 @@ func (s YAMLStorage) List() ([]relation.Relation, *relation.Error) {
 				Name: ym.Child.Name,
 				Keys: ym.Child.Keys,
 			},
+			Virtual: ym.Virtual,
 		}
 		result = append(result, m)
 	}
@@ -98,6 +100,7 @@ func (s YAMLStorage) Store(relations []relation.Relation) *relation.Error {
 				Name: r.Child.Name,
 				Keys: r.Child.Keys,
 			},
+			Virtual: r.Virtual,
 		}
 		list.Relations = append(list.Relations, yml)
 	}
@@ -115,6 +118,10 @@ func readFile() (*YAMLStructure, *relation.Error) {
 		Version: Version,
 	}
 
+	if _, err := os.Stat("relations.yaml"); os.IsNotExist(err) {
+		return list, nil
+	}
+
 	dat, err := os.ReadFile("relations.yaml")
 	if err != nil {
 		return nil, &relation.Error{Description: err.Error()}
@@ -0,0 +1,47 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package relation
+
+import (
+	"testing"
+
+	"github.com/cgi-fr/lino/pkg/relation"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMissingFile(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	storage := NewYAMLStorage()
+
+	relations, err := storage.List()
+	assert.Nil(t, err)
+	assert.Empty(t, relations)
+
+	virtual := relation.Relation{
+		Name:   "virtual",
+		Parent: relation.Table{Name: "customer", Keys: []string{"id"}},
+		Child:  relation.Table{Name: "order", Keys: []string{"customer_id"}},
+	}
+	assert.Nil(t, relation.Add(storage, virtual))
+
+	relations, err = storage.List()
+	assert.Nil(t, err)
+	virtual.Virtual = true
+	assert.Equal(t, []relation.Relation{virtual}, relations)
+}
@@ -0,0 +1,44 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+package sequence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMariadbDialect(t *testing.T) {
+	d := MariadbDialect{}
+
+	assert.Equal(t, "ALTER TABLE shop.orders AUTO_INCREMENT = 1", d.UpdateSequenceSQL("shop", "orders.id", "orders", "id"))
+	assert.Equal(t, "SELECT auto_increment FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = 'orders'",
+		d.StatusSequenceSQL("", "orders.id"))
+}
+
+func TestSQLServerDialect(t *testing.T) {
+	d := SQLServerDialect{}
+
+	assert.Contains(t, d.UpdateSequenceSQL("dbo", "orders.id", "orders", "id"), "DBCC CHECKIDENT ('dbo.orders', RESEED, @last_val)")
+	// the table of an identity column is the one of its name
+	assert.Contains(t, d.UpdateSequenceSQL("dbo", "order_items.item_id", "order", "id"), "DBCC CHECKIDENT ('dbo.order_items', RESEED, @last_val)")
+	assert.Contains(t, d.UpdateSequenceSQL("dbo", "order_items.item_id", "order", "id"), "SELECT MAX(item_id) FROM dbo.order_items")
+	assert.Contains(t, d.UpdateSequenceSQL("dbo", "orders_seq", "orders", "id"), "ALTER SEQUENCE dbo.orders_seq RESTART WITH")
+	assert.Equal(t, "SELECT CAST(IDENT_CURRENT('dbo.orders') AS BIGINT)", d.StatusSequenceSQL("dbo", "orders.id"))
+	assert.Equal(t, "SELECT CAST(current_value AS BIGINT) FROM sys.sequences WHERE name = 'orders_seq' AND SCHEMA_NAME(schema_id) = SCHEMA_NAME()",
+		d.StatusSequenceSQL("", "orders_seq"))
+}
@@ -0,0 +1,92 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+//go:build db2
+// +build db2
+
+package sequence
+
+import (
+	"fmt"
+
+	// import db2 connector
+	_ "github.com/ibmdb/go_ibm_db"
+
+	"github.com/cgi-fr/lino/pkg/sequence"
+)
+
+// NewDb2UpdatorFactory creates a new db2 updator factory.
+func NewDb2UpdatorFactory() *Db2UpdatorFactory {
+	return &Db2UpdatorFactory{}
+}
+
+// Db2UpdatorFactory exposes methods to create new Db2 updators.
+type Db2UpdatorFactory struct{}
+
+// New return a Db2 updator
+func (e *Db2UpdatorFactory) New(url string, schema string) sequence.Updator {
+	return NewSQLUpdator(url, schema, Db2Dialect{})
+}
+
+// Db2Dialect lists the sequences, and the identity columns as sequences named table.column.
+type Db2Dialect struct{}
+
+func (d Db2Dialect) SequencesSQL(schema string) string {
+	return fmt.Sprintf(`SELECT TRIM(SEQNAME) FROM SYSCAT.SEQUENCES WHERE SEQTYPE = 'S' AND SEQSCHEMA = %[1]s
+UNION ALL
+SELECT TRIM(TABNAME) || '.' || TRIM(COLNAME) FROM SYSCAT.COLUMNS WHERE IDENTITY = 'Y' AND TABSCHEMA = %[1]s`, d.schema(schema))
+}
+
+func (d Db2Dialect) UpdateSequenceSQL(schema string, sequenceName string, tableName string, column string) string {
+	// identity columns are restarted on the table of their name
+	identityTable, identityColumn, identity := sequence.IdentityColumn(sequenceName)
+	if identity {
+		tableName, column = identityTable, identityColumn
+	}
+	if schema != "" {
+		tableName = schema + "." + tableName
+		sequenceName = schema + "." + sequenceName
+	}
+
+	restart := fmt.Sprintf("ALTER SEQUENCE %s RESTART WITH ", sequenceName)
+	if identity {
+		restart = fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s RESTART WITH ", tableName, column)
+	}
+
+	return fmt.Sprintf(`BEGIN
+	DECLARE next_val BIGINT;
+	SET next_val = (SELECT MAX(%s) + 1 FROM %s);
+	IF next_val IS NOT NULL THEN
+		EXECUTE IMMEDIATE '%s' || VARCHAR(next_val);
+	END IF;
+END`, column, tableName, restart)
+}
+
+func (d Db2Dialect) StatusSequenceSQL(schema string, sequenceName string) string {
+	if tableName, column, ok := sequence.IdentityColumn(sequenceName); ok {
+		return fmt.Sprintf("SELECT BIGINT(NEXTCACHEFIRSTVALUE) FROM SYSCAT.COLIDENTATTRIBUTES WHERE TABSCHEMA = %s AND TABNAME = '%s' AND COLNAME = '%s'",
+			d.schema(schema), tableName, column)
+	}
+	return fmt.Sprintf("SELECT BIGINT(NEXTCACHEFIRSTVALUE) FROM SYSCAT.SEQUENCES WHERE SEQSCHEMA = %s AND SEQNAME = '%s'",
+		d.schema(schema), sequenceName)
+}
+
+func (d Db2Dialect) schema(schema string) string {
+	if schema == "" {
+		return "CURRENT SCHEMA"
+	}
+	return "'" + schema + "'"
+}
@@ -0,0 +1,53 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+//go:build !db2
+// +build !db2
+
+package sequence
+
+import (
+	"fmt"
+
+	"github.com/cgi-fr/lino/pkg/sequence"
+)
+
+// NewDb2UpdatorFactory creates a new db2 updator factory.
+func NewDb2UpdatorFactory() *Db2UpdatorFactory {
+	return &Db2UpdatorFactory{}
+}
+
+// Db2UpdatorFactory exposes methods to create new Db2 updators.
+type Db2UpdatorFactory struct{}
+
+// New return a Db2 updator
+func (e *Db2UpdatorFactory) New(url string, schema string) sequence.Updator {
+	return NewSQLUpdator(url, schema, Db2Dialect{})
+}
+
+type Db2Dialect struct{}
+
+func (d Db2Dialect) SequencesSQL(schema string) string {
+	panic(fmt.Errorf("not implemented"))
+}
+
+func (d Db2Dialect) UpdateSequenceSQL(schema string, sequence string, tableName string, column string) string {
+	panic(fmt.Errorf("not implemented"))
+}
+
+func (d Db2Dialect) StatusSequenceSQL(schema string, sequence string) string {
+	panic(fmt.Errorf("not implemented"))
+}
@@ -0,0 +1,71 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+package sequence
+
+import (
+	"fmt"
+
+	// import mariadb connector
+	_ "github.com/go-sql-driver/mysql"
+
+	"github.com/cgi-fr/lino/pkg/sequence"
+)
+
+// NewMariadbUpdatorFactory creates a new mariadb updator factory.
+func NewMariadbUpdatorFactory() *MariadbUpdatorFactory {
+	return &MariadbUpdatorFactory{}
+}
+
+// MariadbUpdatorFactory exposes methods to create new Mariadb updators.
+type MariadbUpdatorFactory struct{}
+
+// New return a Mariadb updator
+func (e *MariadbUpdatorFactory) New(url string, schema string) sequence.Updator {
+	return NewSQLUpdator(url, schema, MariadbDialect{})
+}
+
+// MariadbDialect lists the AUTO_INCREMENT columns as sequences named table.column.
+type MariadbDialect struct{}
+
+func (d MariadbDialect) SequencesSQL(schema string) string {
+	return fmt.Sprintf(`SELECT CONCAT(table_name, '.', column_name) FROM information_schema.columns
+WHERE extra LIKE '%%auto_increment%%' AND table_schema = %s`, d.schema(schema))
+}
+
+// UpdateSequenceSQL sets the AUTO_INCREMENT to 1, which MariaDB raises to the maximum value of the column + 1.
+func (d MariadbDialect) UpdateSequenceSQL(schema string, sequenceName string, tableName string, column string) string {
+	if identityTable, _, ok := sequence.IdentityColumn(sequenceName); ok {
+		tableName = identityTable
+	}
+	if schema != "" {
+		tableName = schema + "." + tableName
+	}
+	return fmt.Sprintf("ALTER TABLE %s AUTO_INCREMENT = 1", tableName)
+}
+
+func (d MariadbDialect) StatusSequenceSQL(schema string, sequenceName string) string {
+	tableName, _, _ := sequence.IdentityColumn(sequenceName)
+	return fmt.Sprintf("SELECT auto_increment FROM information_schema.tables WHERE table_schema = %s AND table_name = '%s'",
+		d.schema(schema), tableName)
+}
+
+func (d MariadbDialect) schema(schema string) string {
+	if schema == "" {
+		return "DATABASE()"
+	}
+	return "'" + schema + "'"
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+package sequence
+
+import (
+	"fmt"
+
+	_ "github.com/microsoft/go-mssqldb"
+
+	"github.com/cgi-fr/lino/pkg/sequence"
+)
+
+// NewSQLServerUpdatorFactory creates a new SQL Server updator factory.
+func NewSQLServerUpdatorFactory() *SQLServerUpdatorFactory {
+	return &SQLServerUpdatorFactory{}
+}
+
+// SQLServerUpdatorFactory exposes methods to create new SQL Server updators.
+type SQLServerUpdatorFactory struct{}
+
+// New return a SQL Server updator
+func (e *SQLServerUpdatorFactory) New(url string, schema string) sequence.Updator {
+	return NewSQLUpdator(url, schema, SQLServerDialect{})
+}
+
+// SQLServerDialect lists the SEQUENCE objects, and the IDENTITY columns as sequences named table.column.
+type SQLServerDialect struct{}
+
+func (d SQLServerDialect) SequencesSQL(schema string) string {
+	return fmt.Sprintf(`SELECT s.name FROM sys.sequences s WHERE SCHEMA_NAME(s.schema_id) = %[1]s
+UNION ALL
+SELECT CONCAT(t.name, '.', c.name) FROM sys.identity_columns c
+JOIN sys.tables t ON t.object_id = c.object_id
+WHERE SCHEMA_NAME(t.schema_id) = %[1]s`, d.schema(schema))
+}
+
+func (d SQLServerDialect) UpdateSequenceSQL(schema string, sequenceName string, tableName string, column string) string {
+	// identity columns are reseeded on the table of their name
+	identityTable, identityColumn, identity := sequence.IdentityColumn(sequenceName)
+	if identity {
+		tableName, column = identityTable, identityColumn
+	}
+	if schema != "" {
+		tableName = schema + "." + tableName
+		sequenceName = schema + "." + sequenceName
+	}
+
+	if identity {
+		return fmt.Sprintf(`DECLARE @last_val BIGINT = (SELECT MAX(%s) FROM %s);
+IF @last_val IS NOT NULL DBCC CHECKIDENT ('%s', RESEED, @last_val);`, column, tableName, tableName)
+	}
+	return fmt.Sprintf(`DECLARE @next_val BIGINT = (SELECT MAX(%s) + 1 FROM %s);
+IF @next_val IS NOT NULL EXEC('ALTER SEQUENCE %s RESTART WITH ' + CAST(@next_val AS VARCHAR(20)));`, column, tableName, sequenceName)
+}
+
+func (d SQLServerDialect) StatusSequenceSQL(schema string, sequenceName string) string {
+	if tableName, _, ok := sequence.IdentityColumn(sequenceName); ok {
+		if schema != "" {
+			tableName = schema + "." + tableName
+		}
+		return fmt.Sprintf("SELECT CAST(IDENT_CURRENT('%s') AS BIGINT)", tableName)
+	}
+	return fmt.Sprintf("SELECT CAST(current_value AS BIGINT) FROM sys.sequences WHERE name = '%s' AND SCHEMA_NAME(schema_id) = %s",
+		sequenceName, d.schema(schema))
+}
+
+func (d SQLServerDialect) schema(schema string) string {
+	if schema == "" {
+		return "SCHEMA_NAME()"
+	}
+	return "'" + schema + "'"
+}
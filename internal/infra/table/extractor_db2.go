@@ -43,6 +43,11 @@ func (e *Db2ExtractorFactory) New(url string, schema string) table.Extractor {
 	return NewSQLExtractor(url, schema, Db2Dialect{commonsql.Db2Dialect{}})
 }
 
+// NewScoped return a Db2 extractor of the objects of the types in the schemas
+func (e *Db2ExtractorFactory) NewScoped(url string, schemas []string, types []table.ObjectType) table.Extractor {
+	return NewScopedSQLExtractor(url, schemas, types, Db2Dialect{commonsql.Db2Dialect{}})
+}
+
 type Db2Dialect struct {
 	commonsql.Dialect
 }
@@ -76,3 +81,84 @@ func (d Db2Dialect) SQL(schema string) string {
 func (d Db2Dialect) GetExportType(dbtype string) (string, bool) {
 	return "", false
 }
+
+// inSchema filters the column of schema on the schema, or on the current schema.
+func (d Db2Dialect) inSchema(column string, schema string) string {
+	if schema == "" {
+		return column + " = CURRENT SCHEMA"
+	}
+	return fmt.Sprintf("%s = '%s'", column, schema)
+}
+
+func (d Db2Dialect) ColumnsSQL(schema string) string {
+	return `SELECT c.tabname,
+	c.colname,
+	CASE WHEN c.nulls = 'N' THEN 1 ELSE 0 END,
+	CASE WHEN c.identity = 'N' AND c.generated <> ' ' THEN VARCHAR(c.text, 2000) ELSE c.default END,
+	c.remarks,
+	CASE WHEN c.identity = 'Y' THEN 1 ELSE 0 END,
+	CASE WHEN c.identity = 'N' AND c.generated <> ' ' THEN 1 ELSE 0 END
+FROM syscat.columns c
+JOIN syscat.tables t ON t.tabschema = c.tabschema AND t.tabname = c.tabname
+WHERE t.type = 'T'
+AND ` + d.inSchema("c.tabschema", schema) + `
+ORDER BY c.tabname,
+	c.colno`
+}
+
+func (d Db2Dialect) IndexesSQL(schema string) string {
+	return `SELECT i.tabname,
+	i.indname,
+	CASE WHEN i.uniquerule = 'U' THEN 1 ELSE 0 END,
+	ic.colname
+FROM syscat.indexes i
+JOIN syscat.indexcoluse ic ON ic.indschema = i.indschema AND ic.indname = i.indname
+WHERE i.uniquerule <> 'P'
+AND ` + d.inSchema("i.tabschema", schema) + `
+ORDER BY i.tabname,
+	i.indname,
+	ic.colseq`
+}
+
+func (d Db2Dialect) ChecksSQL(schema string) string {
+	return `SELECT ck.tabname,
+	ck.constname,
+	VARCHAR(ck.text, 2000)
+FROM syscat.checks ck
+WHERE ck.type = 'C'
+AND ` + d.inSchema("ck.tabschema", schema) + `
+ORDER BY ck.tabname,
+	ck.constname`
+}
+
+func (d Db2Dialect) CommentsSQL(schema string) string {
+	return `SELECT t.tabname,
+	t.remarks
+FROM syscat.tables t
+WHERE t.type = 'T'
+AND t.remarks IS NOT NULL
+AND ` + d.inSchema("t.tabschema", schema)
+}
+
+func (d Db2Dialect) ObjectsSQL(schema string, objectType table.ObjectType) string {
+	var SQL string
+
+	switch objectType {
+	case table.ObjectTypeView:
+		SQL = "SELECT tabschema, tabname FROM syscat.tables WHERE type = 'V'"
+	case table.ObjectTypeMaterializedView:
+		SQL = "SELECT tabschema, tabname FROM syscat.tables WHERE type = 'S'"
+	case table.ObjectTypeSynonym:
+		SQL = "SELECT tabschema, tabname FROM syscat.tables WHERE type = 'A'"
+	default:
+		return ""
+	}
+
+	if schema != "" {
+		SQL += fmt.Sprintf(" AND tabschema = '%s'", schema)
+	} else {
+		SQL += " AND tabschema NOT LIKE 'SYS%'"
+	}
+
+	return SQL + " ORDER BY 1, 2"
+}
@@ -40,6 +40,11 @@ func (e *Db2ExtractorFactory) New(url string, schema string) table.Extractor {
 	return NewSQLExtractor(url, schema, Db2Dialect{commonsql.Db2Dialect{}})
 }
 
+// NewScoped return a Db2 extractor of the objects of the types in the schemas
+func (e *Db2ExtractorFactory) NewScoped(url string, schemas []string, types []table.ObjectType) table.Extractor {
+	return NewScopedSQLExtractor(url, schemas, types, Db2Dialect{commonsql.Db2Dialect{}})
+}
+
 type Db2Dialect struct {
 	commonsql.Dialect
 }
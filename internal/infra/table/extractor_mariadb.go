@@ -40,6 +40,11 @@ func (e *MariadbExtractorFactory) New(url string, schema string) table.Extractor
 	return NewSQLExtractor(url, schema, MariadbDialect{commonsql.MariadbDialect{}})
 }
 
+// NewScoped return a Mariadb extractor of the objects of the types in the schemas
+func (e *MariadbExtractorFactory) NewScoped(url string, schemas []string, types []table.ObjectType) table.Extractor {
+	return NewScopedSQLExtractor(url, schemas, types, MariadbDialect{commonsql.MariadbDialect{}})
+}
+
 type MariadbDialect struct {
 	commonsql.Dialect
 }
@@ -90,3 +95,76 @@ func (d MariadbDialect) GetExportType(dbtype string) (string, bool) {
 		return "", false
 	}
 }
+
+// inSchema filters the column table_schema of alias on the schema, or on the current database.
+func (d MariadbDialect) inSchema(alias string, schema string) string {
+	if schema == "" {
+		return alias + ".table_schema = DATABASE()"
+	}
+	return fmt.Sprintf("%s.table_schema = '%s'", alias, schema)
+}
+
+func (d MariadbDialect) ColumnsSQL(schema string) string {
+	return `SELECT c.table_name,
+	c.column_name,
+	CASE WHEN c.is_nullable = 'NO' THEN 1 ELSE 0 END,
+	CASE WHEN c.extra IN ('VIRTUAL GENERATED', 'STORED GENERATED', 'PERSISTENT GENERATED') THEN c.generation_expression ELSE c.column_default END,
+	NULLIF(c.column_comment, ''),
+	CASE WHEN c.extra LIKE '%auto_increment%' THEN 1 ELSE 0 END,
+	CASE WHEN c.extra IN ('VIRTUAL GENERATED', 'STORED GENERATED', 'PERSISTENT GENERATED') THEN 1 ELSE 0 END
+FROM information_schema.columns c
+WHERE ` + d.inSchema("c", schema) + `
+ORDER BY c.table_name,
+	c.ordinal_position`
+}
+
+func (d MariadbDialect) IndexesSQL(schema string) string {
+	return `SELECT s.table_name,
+	s.index_name,
+	CASE WHEN s.non_unique = 0 THEN 1 ELSE 0 END,
+	s.column_name
+FROM information_schema.statistics s
+WHERE s.index_name <> 'PRIMARY'
+AND ` + d.inSchema("s", schema) + `
+ORDER BY s.table_name,
+	s.index_name,
+	s.seq_in_index`
+}
+
+func (d MariadbDialect) ChecksSQL(schema string) string {
+	return `SELECT tc.table_name,
+	cc.constraint_name,
+	cc.check_clause
+FROM information_schema.table_constraints tc
+JOIN information_schema.check_constraints cc
+ON cc.constraint_schema = tc.constraint_schema
+AND cc.constraint_name = tc.constraint_name
+WHERE tc.constraint_type = 'CHECK'
+AND ` + d.inSchema("tc", schema) + `
+ORDER BY tc.table_name,
+	cc.constraint_name`
+}
+
+func (d MariadbDialect) CommentsSQL(schema string) string {
+	return `SELECT t.table_name,
+	t.table_comment
+FROM information_schema.tables t
+WHERE t.table_type = 'BASE TABLE'
+AND t.table_comment <> ''
+AND ` + d.inSchema("t", schema)
+}
+
+func (d MariadbDialect) ObjectsSQL(schema string, objectType table.ObjectType) string {
+	if objectType != table.ObjectTypeView {
+		return ""
+	}
+
+	SQL := `SELECT table_schema, table_name
+FROM information_schema.views
+WHERE table_schema NOT IN ('mysql', 'sys', 'information_schema', 'performance_schema')`
+	if schema != "" {
+		SQL += fmt.Sprintf("\nAND table_schema = '%s'", schema)
+	}
+
+	return SQL + "\nORDER BY 1, 2"
+}
@@ -23,6 +23,11 @@ func (e *OracleExtractorFactory) New(url string, schema string) table.Extractor
 	return NewSQLExtractor(url, schema, OracleDialect{commonsql.OracleDialect{}})
 }
 
+// NewScoped return a Oracle extractor of the objects of the types in the schemas
+func (e *OracleExtractorFactory) NewScoped(url string, schemas []string, types []table.ObjectType) table.Extractor {
+	return NewScopedSQLExtractor(url, schemas, types, OracleDialect{commonsql.OracleDialect{}})
+}
+
 type OracleDialect struct {
 	commonsql.Dialect
 }
@@ -78,3 +83,89 @@ func (d OracleDialect) GetExportType(dbtype string) (string, bool) {
 		return "", false
 	}
 }
+
+// inSchema filters the column of owner on the schema, or on the current user.
+func (d OracleDialect) inSchema(column string, schema string) string {
+	if schema == "" {
+		return column + " = user"
+	}
+	return fmt.Sprintf("%s = '%s'", column, schema)
+}
+
+func (d OracleDialect) ColumnsSQL(schema string) string {
+	return `SELECT c.table_name,
+	c.column_name,
+	CASE WHEN c.nullable = 'N' THEN 1 ELSE 0 END,
+	c.data_default,
+	cc.comments,
+	CASE WHEN c.identity_column = 'YES' THEN 1 ELSE 0 END,
+	CASE WHEN c.virtual_column = 'YES' THEN 1 ELSE 0 END
+FROM all_tab_cols c
+LEFT JOIN all_col_comments cc
+ON cc.owner = c.owner
+AND cc.table_name = c.table_name
+AND cc.column_name = c.column_name
+WHERE c.hidden_column = 'NO'
+AND ` + d.inSchema("c.owner", schema) + `
+ORDER BY c.table_name,
+	c.column_id`
+}
+
+func (d OracleDialect) IndexesSQL(schema string) string {
+	return `SELECT i.table_name,
+	i.index_name,
+	CASE WHEN i.uniqueness = 'UNIQUE' THEN 1 ELSE 0 END,
+	ic.column_name
+FROM all_indexes i
+JOIN all_ind_columns ic
+ON ic.index_owner = i.owner
+AND ic.index_name = i.index_name
+WHERE NOT EXISTS (
+	SELECT 1 FROM all_constraints pk
+	WHERE pk.owner = i.table_owner
+	AND pk.index_name = i.index_name
+	AND pk.constraint_type = 'P'
+)
+AND ` + d.inSchema("i.table_owner", schema) + `
+ORDER BY i.table_name,
+	i.index_name,
+	ic.column_position`
+}
+
+func (d OracleDialect) ChecksSQL(schema string) string {
+	return `SELECT c.table_name,
+	c.constraint_name,
+	c.search_condition_vc
+FROM all_constraints c
+WHERE c.constraint_type = 'C'
+AND c.search_condition_vc NOT LIKE '"%" IS NOT NULL'
+AND ` + d.inSchema("c.owner", schema) + `
+ORDER BY c.table_name,
+	c.constraint_name`
+}
+
+func (d OracleDialect) CommentsSQL(schema string) string {
+	return `SELECT t.table_name,
+	t.comments
+FROM all_tab_comments t
+WHERE t.table_type = 'TABLE'
+AND t.comments IS NOT NULL
+AND ` + d.inSchema("t.owner", schema)
+}
+
+func (d OracleDialect) ObjectsSQL(schema string, objectType table.ObjectType) string {
+	var SQL string
+
+	switch objectType {
+	case table.ObjectTypeView:
+		SQL = "SELECT owner, view_name FROM all_views WHERE " + d.inSchema("owner", schema)
+	case table.ObjectTypeMaterializedView:
+		SQL = "SELECT owner, mview_name FROM all_mviews WHERE " + d.inSchema("owner", schema)
+	case table.ObjectTypeSynonym:
+		SQL = "SELECT owner, synonym_name FROM all_synonyms WHERE " + d.inSchema("owner", schema)
+	default:
+		return ""
+	}
+
+	return SQL + " ORDER BY 1, 2"
+}
@@ -40,6 +40,11 @@ func (e *PostgresExtractorFactory) New(url string, schema string) table.Extracto
 	return NewSQLExtractor(url, schema, PostgresDialect{commonsql.PostgresDialect{}})
 }
 
+// NewScoped return a Postgres extractor of the objects of the types in the schemas
+func (e *PostgresExtractorFactory) NewScoped(url string, schemas []string, types []table.ObjectType) table.Extractor {
+	return NewScopedSQLExtractor(url, schemas, types, PostgresDialect{commonsql.PostgresDialect{}})
+}
+
 type PostgresDialect struct {
 	commonsql.Dialect
 }
@@ -95,3 +100,96 @@ func (d PostgresDialect) GetExportType(dbtype string) (string, bool) {
 		return "string", true // default to export string since it will work most of the time (binary types are already handled)
 	}
 }
+
+// inSchema filters the namespace n on the schema, or on the current schema.
+func (d PostgresDialect) inSchema(schema string) string {
+	if schema == "" {
+		return "n.nspname = current_schema()"
+	}
+	return fmt.Sprintf("n.nspname = '%s'", schema)
+}
+
+func (d PostgresDialect) ColumnsSQL(schema string) string {
+	return `SELECT c.relname,
+	a.attname,
+	CASE WHEN a.attnotnull THEN 1 ELSE 0 END,
+	pg_get_expr(ad.adbin, ad.adrelid),
+	col_description(c.oid, a.attnum),
+	CASE WHEN a.attidentity <> '' THEN 1 ELSE 0 END,
+	CASE WHEN a.attgenerated <> '' THEN 1 ELSE 0 END
+FROM pg_attribute a
+JOIN pg_class c ON c.oid = a.attrelid
+JOIN pg_namespace n ON n.oid = c.relnamespace
+LEFT JOIN pg_attrdef ad ON ad.adrelid = a.attrelid AND ad.adnum = a.attnum
+WHERE a.attnum > 0
+AND NOT a.attisdropped
+AND c.relkind IN ('r', 'p')
+AND ` + d.inSchema(schema) + `
+ORDER BY c.relname,
+	a.attnum`
+}
+
+func (d PostgresDialect) IndexesSQL(schema string) string {
+	return `SELECT t.relname,
+	i.relname,
+	CASE WHEN ix.indisunique THEN 1 ELSE 0 END,
+	a.attname
+FROM pg_index ix
+JOIN pg_class t ON t.oid = ix.indrelid
+JOIN pg_class i ON i.oid = ix.indexrelid
+JOIN pg_namespace n ON n.oid = t.relnamespace
+CROSS JOIN LATERAL unnest(ix.indkey) WITH ORDINALITY AS k(attnum, position)
+JOIN pg_attribute a ON a.attrelid = t.oid AND a.attnum = k.attnum
+WHERE NOT ix.indisprimary
+AND ` + d.inSchema(schema) + `
+ORDER BY t.relname,
+	i.relname,
+	k.position`
+}
+
+func (d PostgresDialect) ChecksSQL(schema string) string {
+	return `SELECT t.relname,
+	co.conname,
+	pg_get_constraintdef(co.oid)
+FROM pg_constraint co
+JOIN pg_class t ON t.oid = co.conrelid
+JOIN pg_namespace n ON n.oid = t.relnamespace
+WHERE co.contype = 'c'
+AND ` + d.inSchema(schema) + `
+ORDER BY t.relname,
+	co.conname`
+}
+
+func (d PostgresDialect) CommentsSQL(schema string) string {
+	return `SELECT c.relname,
+	obj_description(c.oid, 'pg_class')
+FROM pg_class c
+JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE c.relkind IN ('r', 'p')
+AND obj_description(c.oid, 'pg_class') IS NOT NULL
+AND ` + d.inSchema(schema)
+}
+
+func (d PostgresDialect) ObjectsSQL(schema string, objectType table.ObjectType) string {
+	var SQL string
+
+	switch objectType {
+	case table.ObjectTypeView:
+		SQL = `SELECT table_schema, table_name
+FROM information_schema.views
+WHERE table_schema NOT IN ('pg_catalog', 'information_schema')`
+		if schema != "" {
+			SQL += fmt.Sprintf("\nAND table_schema = '%s'", schema)
+		}
+	case table.ObjectTypeMaterializedView:
+		SQL = `SELECT schemaname, matviewname
+FROM pg_matviews`
+		if schema != "" {
+			SQL += fmt.Sprintf("\nWHERE schemaname = '%s'", schema)
+		}
+	default:
+		return ""
+	}
+
+	return SQL + "\nORDER BY 1, 2"
+}
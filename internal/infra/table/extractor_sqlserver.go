@@ -39,6 +39,11 @@ func (e *SQLServerExtractorFactory) New(connectionString string, schema string)
 	return NewSQLExtractor(connectionString, schema, SQLServerDialect{commonsql.SQLServerDialect{}})
 }
 
+// NewScoped return a SQLServer extractor of the objects of the types in the schemas
+func (e *SQLServerExtractorFactory) NewScoped(connectionString string, schemas []string, types []table.ObjectType) table.Extractor {
+	return NewScopedSQLExtractor(connectionString, schemas, types, SQLServerDialect{commonsql.SQLServerDialect{}})
+}
+
 type SQLServerDialect struct {
 	commonsql.Dialect
 }
@@ -94,3 +99,93 @@ func (d SQLServerDialect) GetExportType(dbtype string) (string, bool) {
 		return "", false
 	}
 }
+
+// inSchema filters the schema of the table t on the schema, or on the default schema of the user.
+func (d SQLServerDialect) inSchema(schema string) string {
+	if schema == "" {
+		return "SCHEMA_NAME(t.schema_id) = SCHEMA_NAME()"
+	}
+	return fmt.Sprintf("SCHEMA_NAME(t.schema_id) = '%s'", schema)
+}
+
+func (d SQLServerDialect) ColumnsSQL(schema string) string {
+	return `SELECT t.name,
+	c.name,
+	CASE WHEN c.is_nullable = 0 THEN 1 ELSE 0 END,
+	COALESCE(cc.definition, dc.definition),
+	CAST(ep.value AS NVARCHAR(4000)),
+	CASE WHEN c.is_identity = 1 THEN 1 ELSE 0 END,
+	CASE WHEN c.is_computed = 1 THEN 1 ELSE 0 END
+FROM sys.columns c
+JOIN sys.tables t ON t.object_id = c.object_id
+LEFT JOIN sys.default_constraints dc ON dc.object_id = c.default_object_id
+LEFT JOIN sys.computed_columns cc ON cc.object_id = c.object_id AND cc.column_id = c.column_id
+LEFT JOIN sys.extended_properties ep
+ON ep.class = 1
+AND ep.major_id = c.object_id
+AND ep.minor_id = c.column_id
+AND ep.name = 'MS_Description'
+WHERE ` + d.inSchema(schema) + `
+ORDER BY t.name,
+	c.column_id`
+}
+
+func (d SQLServerDialect) IndexesSQL(schema string) string {
+	return `SELECT t.name,
+	i.name,
+	CASE WHEN i.is_unique = 1 THEN 1 ELSE 0 END,
+	c.name
+FROM sys.indexes i
+JOIN sys.tables t ON t.object_id = i.object_id
+JOIN sys.index_columns ic ON ic.object_id = i.object_id AND ic.index_id = i.index_id
+JOIN sys.columns c ON c.object_id = ic.object_id AND c.column_id = ic.column_id
+WHERE i.is_primary_key = 0
+AND i.type > 0
+AND ic.is_included_column = 0
+AND ` + d.inSchema(schema) + `
+ORDER BY t.name,
+	i.name,
+	ic.key_ordinal`
+}
+
+func (d SQLServerDialect) ChecksSQL(schema string) string {
+	return `SELECT t.name,
+	ck.name,
+	ck.definition
+FROM sys.check_constraints ck
+JOIN sys.tables t ON t.object_id = ck.parent_object_id
+WHERE ` + d.inSchema(schema) + `
+ORDER BY t.name,
+	ck.name`
+}
+
+func (d SQLServerDialect) CommentsSQL(schema string) string {
+	return `SELECT t.name,
+	CAST(ep.value AS NVARCHAR(4000))
+FROM sys.tables t
+JOIN sys.extended_properties ep
+ON ep.class = 1
+AND ep.major_id = t.object_id
+AND ep.minor_id = 0
+AND ep.name = 'MS_Description'
+WHERE ` + d.inSchema(schema)
+}
+
+func (d SQLServerDialect) ObjectsSQL(schema string, objectType table.ObjectType) string {
+	var SQL string
+
+	switch objectType {
+	case table.ObjectTypeView:
+		SQL = "SELECT SCHEMA_NAME(schema_id), name FROM sys.views WHERE is_ms_shipped = 0"
+	case table.ObjectTypeSynonym:
+		SQL = "SELECT SCHEMA_NAME(schema_id), name FROM sys.synonyms WHERE 1 = 1"
+	default:
+		return ""
+	}
+
+	if schema != "" {
+		SQL += fmt.Sprintf(" AND SCHEMA_NAME(schema_id) = '%s'", schema)
+	}
+
+	return SQL + " ORDER BY 1, 2"
+}
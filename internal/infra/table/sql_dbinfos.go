@@ -0,0 +1,176 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package table
+
+import (
+	"database/sql"
+
+	"github.com/cgi-fr/lino/pkg/table"
+	"github.com/rs/zerolog/log"
+)
+
+// DBInfosDialect gives the SQL queries reading the constraints and comments of the tables of a schema (or of the
+// current schema if schema is empty), they are used with the flag --with-db-infos. Flags are returned as 0 or 1.
+type DBInfosDialect interface {
+	// ColumnsSQL returns table, column, not null flag, default (or generation) expression, comment, identity flag,
+	// generated flag, ordered by table and column position.
+	ColumnsSQL(schema string) string
+	// IndexesSQL returns table, index, unique flag, column, ordered by table, index and column position in the index.
+	// The index of the primary key is excluded.
+	IndexesSQL(schema string) string
+	// ChecksSQL returns table, constraint, check expression, ordered by table and constraint.
+	ChecksSQL(schema string) string
+	// CommentsSQL returns table, comment, for tables with a comment.
+	CommentsSQL(schema string) string
+}
+
+// readDBInfos completes the tables with constraints and comments, if the dialect can read them.
+func (e *SQLExtractor) readDBInfos(db *sql.DB, schema string, tables []table.Table) *table.Error {
+	dialect, ok := e.dialect.(DBInfosDialect)
+	if !ok {
+		log.Warn().Msg("constraints and comments are not extracted with this database")
+		return nil
+	}
+
+	index := map[string]*table.Table{}
+	for i := range tables {
+		index[tables[i].Name] = &tables[i]
+	}
+
+	if err := e.readColumns(db, dialect.ColumnsSQL(schema), index); err != nil {
+		return err
+	}
+
+	if err := e.readIndexes(db, dialect.IndexesSQL(schema), index); err != nil {
+		return err
+	}
+
+	if err := e.readChecks(db, dialect.ChecksSQL(schema), index); err != nil {
+		return err
+	}
+
+	return e.readComments(db, dialect.CommentsSQL(schema), index)
+}
+
+func (e *SQLExtractor) readColumns(db *sql.DB, query string, index map[string]*table.Table) *table.Error {
+	var (
+		tableName    string
+		columnName   string
+		notNull      int
+		defaultValue sql.NullString
+		comment      sql.NullString
+		identity     int
+		generated    int
+	)
+
+	return e.query(db, query, []interface{}{&tableName, &columnName, &notNull, &defaultValue, &comment, &identity, &generated}, func() {
+		t, ok := index[tableName]
+		if !ok {
+			return
+		}
+
+		for i := range t.Columns {
+			if t.Columns[i].Name != columnName {
+				continue
+			}
+
+			info := &t.Columns[i].DBInfo
+			info.NotNull = notNull == 1
+			info.Comment = comment.String
+			info.Identity = identity == 1
+			if generated == 1 {
+				info.Generated = defaultValue.String
+			} else {
+				info.Default = defaultValue.String
+			}
+		}
+	})
+}
+
+func (e *SQLExtractor) readIndexes(db *sql.DB, query string, index map[string]*table.Table) *table.Error {
+	var (
+		tableName  string
+		indexName  string
+		unique     int
+		columnName string
+	)
+
+	return e.query(db, query, []interface{}{&tableName, &indexName, &unique, &columnName}, func() {
+		t, ok := index[tableName]
+		if !ok {
+			return
+		}
+
+		if last := len(t.Indexes) - 1; last >= 0 && t.Indexes[last].Name == indexName {
+			t.Indexes[last].Columns = append(t.Indexes[last].Columns, columnName)
+		} else {
+			t.Indexes = append(t.Indexes, table.Index{Name: indexName, Columns: []string{columnName}, Unique: unique == 1})
+		}
+	})
+}
+
+func (e *SQLExtractor) readChecks(db *sql.DB, query string, index map[string]*table.Table) *table.Error {
+	var (
+		tableName  string
+		checkName  string
+		expression string
+	)
+
+	return e.query(db, query, []interface{}{&tableName, &checkName, &expression}, func() {
+		if t, ok := index[tableName]; ok {
+			t.Checks = append(t.Checks, table.Check{Name: checkName, Expression: expression})
+		}
+	})
+}
+
+func (e *SQLExtractor) readComments(db *sql.DB, query string, index map[string]*table.Table) *table.Error {
+	var (
+		tableName string
+		comment   string
+	)
+
+	return e.query(db, query, []interface{}{&tableName, &comment}, func() {
+		if t, ok := index[tableName]; ok {
+			t.Comment = comment
+		}
+	})
+}
+
+// query runs the SQL and calls apply after each row is scanned in dest.
+func (e *SQLExtractor) query(db *sql.DB, query string, dest []interface{}, apply func()) *table.Error {
+	log.Debug().Msgf("Executing SQL to extract db infos: %s", query)
+
+	rows, err := db.Query(query)
+	if err != nil {
+		return &table.Error{Description: err.Error()}
+	}
+	defer rows.Close() //nolint:errcheck
+
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return &table.Error{Description: err.Error()}
+		}
+		apply()
+	}
+
+	if err := rows.Err(); err != nil {
+		return &table.Error{Description: err.Error()}
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package table
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cgi-fr/lino/internal/infra/commonsql"
+	"github.com/cgi-fr/lino/pkg/table"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadDBInfos(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+
+	mock.ExpectQuery(`FROM pg_attribute`).
+		WillReturnRows(sqlmock.NewRows([]string{"table", "column", "notnull", "default", "comment", "identity", "generated"}).
+			AddRow("customer", "id", 1, nil, nil, 1, 0).
+			AddRow("customer", "email", 1, nil, "contact address", 0, 0).
+			AddRow("customer", "country", 0, "'FR'::text", nil, 0, 0).
+			AddRow("customer", "domain", 0, "split_part(email, '@', 2)", nil, 0, 1))
+	mock.ExpectQuery(`FROM pg_index`).
+		WillReturnRows(sqlmock.NewRows([]string{"table", "index", "unique", "column"}).
+			AddRow("customer", "customer_email_key", 1, "email").
+			AddRow("customer", "customer_country_domain_idx", 0, "country").
+			AddRow("customer", "customer_country_domain_idx", 0, "domain"))
+	mock.ExpectQuery(`FROM pg_constraint`).
+		WillReturnRows(sqlmock.NewRows([]string{"table", "constraint", "check"}).
+			AddRow("customer", "customer_email_check", "CHECK ((email ~~ '%@%'::text))"))
+	mock.ExpectQuery(`FROM pg_class`).
+		WillReturnRows(sqlmock.NewRows([]string{"table", "comment"}).
+			AddRow("customer", "customers of the shop"))
+
+	extractor := NewSQLExtractor("", "public", PostgresDialect{commonsql.PostgresDialect{}})
+	tables := []table.Table{{
+		Name:    "customer",
+		Keys:    []string{"id"},
+		Columns: []table.Column{{Name: "id"}, {Name: "email"}, {Name: "country"}, {Name: "domain"}},
+	}}
+
+	e := extractor.readDBInfos(db, "public", tables)
+
+	assert.Nil(t, e)
+	assert.Equal(t, table.Table{
+		Name: "customer",
+		Keys: []string{"id"},
+		Columns: []table.Column{
+			{Name: "id", DBInfo: table.DBInfo{NotNull: true, Identity: true}},
+			{Name: "email", DBInfo: table.DBInfo{NotNull: true, Comment: "contact address"}},
+			{Name: "country", DBInfo: table.DBInfo{Default: "'FR'::text"}},
+			{Name: "domain", DBInfo: table.DBInfo{Generated: "split_part(email, '@', 2)"}},
+		},
+		Comment: "customers of the shop",
+		Indexes: []table.Index{
+			{Name: "customer_email_key", Columns: []string{"email"}, Unique: true},
+			{Name: "customer_country_domain_idx", Columns: []string{"country", "domain"}},
+		},
+		Checks: []table.Check{{Name: "customer_email_check", Expression: "CHECK ((email ~~ '%@%'::text))"}},
+	}, tables[0])
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -19,6 +19,7 @@
 
 import (
 	"database/sql"
+	"fmt"
 	"strings"
 
 	"github.com/cgi-fr/lino/internal/infra/commonsql"
@@ -31,6 +32,8 @@
 type SQLExtractor struct {
 	url     string
 	schema  string
+	others  []string
+	types   []table.ObjectType
 	dialect Dialect
 }
 
@@ -40,6 +43,13 @@ type Dialect interface {
 	GetExportType(dbtype string) (string, bool)
 }
 
+// ObjectsDialect lists the objects of other types than tables, they are extracted with the flag --types.
+type ObjectsDialect interface {
+	// ObjectsSQL returns schema, name of the objects of the type in the schema (or in all schemas if schema is empty),
+	// or an empty string if the database has no object of this type.
+	ObjectsSQL(schema string, objectType table.ObjectType) string
+}
+
 // NewSQLExtractor creates a new SQL extractor.
 func NewSQLExtractor(url string, schema string, dialect Dialect) *SQLExtractor {
 	return &SQLExtractor{
@@ -49,6 +59,18 @@ func NewSQLExtractor(url string, schema string, dialect Dialect) *SQLExtractor {
 	}
 }
 
+// NewScopedSQLExtractor creates a new SQL extractor of the objects of the types in the schemas, the first schema is
+// the default schema and the objects of the other schemas have schema-qualified names.
+func NewScopedSQLExtractor(url string, schemas []string, types []table.ObjectType, dialect Dialect) *SQLExtractor {
+	e := NewSQLExtractor(url, "", dialect)
+	if len(schemas) > 0 {
+		e.schema = schemas[0]
+		e.others = schemas[1:]
+	}
+	e.types = types
+	return e
+}
+
 // Extract tables from the database.
 func (e *SQLExtractor) Extract(onlyTables bool, withDBInfos bool) ([]table.Table, *table.Error) {
 	db, err := dburl.Open(e.url)
@@ -61,7 +83,64 @@ func (e *SQLExtractor) Extract(onlyTables bool, withDBInfos bool) ([]table.Table
 	if err != nil {
 		return nil, &table.Error{Description: err.Error()}
 	}
-	SQL := e.dialect.SQL(e.schema)
+
+	return e.extract(db, onlyTables, withDBInfos)
+}
+
+// extract the objects of each type in each schema.
+func (e *SQLExtractor) extract(db *sql.DB, onlyTables bool, withDBInfos bool) ([]table.Table, *table.Error) {
+	types := e.types
+	if len(types) == 0 {
+		types = []table.ObjectType{table.ObjectTypeTable}
+	}
+
+	schemas := []string{e.schema}
+	if e.schema != "" {
+		schemas = append(schemas, e.others...)
+	} else if len(e.others) > 0 {
+		log.Warn().Strs("schemas", e.others).Msg("other schemas are ignored without default schema, all schemas are read")
+	}
+
+	tables := []table.Table{}
+
+	for i, schema := range schemas {
+		for _, objectType := range types {
+			objects, err := e.extractObjects(db, schema, objectType, onlyTables, withDBInfos)
+			if err != nil {
+				return nil, err
+			}
+
+			if objectType == table.ObjectTypeTable && withDBInfos && !onlyTables {
+				if err := e.readDBInfos(db, schema, objects); err != nil {
+					return nil, err
+				}
+			}
+
+			if i > 0 {
+				for j := range objects {
+					objects[j].Name = schema + "." + objects[j].Name
+				}
+			}
+
+			tables = append(tables, objects...)
+		}
+	}
+
+	return tables, nil
+}
+
+// extractObjects reads the objects of a type in the schema.
+func (e *SQLExtractor) extractObjects(db *sql.DB, schema string, objectType table.ObjectType, onlyTables bool, withDBInfos bool) ([]table.Table, *table.Error) {
+	SQL := ""
+	if objectType == table.ObjectTypeTable {
+		SQL = e.dialect.SQL(schema)
+	} else if dialect, ok := e.dialect.(ObjectsDialect); ok {
+		SQL = dialect.ObjectsSQL(schema, objectType)
+	}
+
+	if SQL == "" {
+		return nil, &table.Error{Description: fmt.Sprintf("object type %s is not supported by this database", objectType)}
+	}
 
 	log.Debug().Msgf("Executing SQL to extract tables: %s", SQL)
 
@@ -69,6 +148,8 @@ func (e *SQLExtractor) Extract(onlyTables bool, withDBInfos bool) ([]table.Table
 	if err != nil {
 		return nil, &table.Error{Description: err.Error()}
 	}
+	defer rows.Close() //nolint:errcheck
+
 	tables := []table.Table{}
 
 	var (
@@ -77,33 +158,40 @@ func (e *SQLExtractor) Extract(onlyTables bool, withDBInfos bool) ([]table.Table
 		keyColumns  string
 	)
 
+	dest := []interface{}{&tableSchema, &tableName, &keyColumns}
+	if objectType != table.ObjectTypeTable {
+		// other objects have no primary key
+		dest = dest[:2]
+	}
+
 	for rows.Next() {
-		err := rows.Scan(&tableSchema, &tableName, &keyColumns)
+		err := rows.Scan(dest...)
 		if err != nil {
 			return nil, &table.Error{Description: err.Error()}
 		}
+
+		t := table.Table{
+			Name: tableName,
+			Keys: []string{},
+		}
+
+		if objectType == table.ObjectTypeTable {
+			t.Keys = strings.Split(keyColumns, ",")
+		} else {
+			t.Type = objectType
+		}
+
 		if !onlyTables {
 			// Get columns information, check is there have types needs to be modify in export
-			columns, err := e.ColumnInfo(db, tableName, withDBInfos)
+			columns, err := e.ColumnInfo(db, schema, tableName, withDBInfos)
 			if err != nil {
 				return nil, &table.Error{Description: err.Error()}
 			}
 
-			table := table.Table{
-				Name:    tableName,
-				Keys:    strings.Split(keyColumns, ","),
-				Columns: columns,
-			}
-
-			tables = append(tables, table)
-		} else {
-			table := table.Table{
-				Name: tableName,
-				Keys: strings.Split(keyColumns, ","),
-			}
-
-			tables = append(tables, table)
+			t.Columns = columns
 		}
+
+		tables = append(tables, t)
 	}
 
 	err = rows.Err()
@@ -152,9 +240,9 @@ func (e *SQLExtractor) Count(tableName string) (int, *table.Error) {
 	return count, nil
 }
 
-func (e *SQLExtractor) ColumnInfo(db *sql.DB, tableName string, withDBInfos bool) ([]table.Column, error) {
+func (e *SQLExtractor) ColumnInfo(db *sql.DB, schema string, tableName string, withDBInfos bool) ([]table.Column, error) {
 	// Execute query to fetch column information
-	query := e.dialect.SelectLimit(tableName, e.schema, "", false, 0)
+	query := e.dialect.SelectLimit(tableName, schema, "", false, nil, 0)
 	rows, err := db.Query(query)
 	if err != nil {
 		log.Warn().Msg("Cannot scan columns informations for table: " + tableName)
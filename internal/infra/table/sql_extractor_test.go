@@ -0,0 +1,70 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package table
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cgi-fr/lino/internal/infra/commonsql"
+	"github.com/cgi-fr/lino/pkg/table"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractScoped(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+
+	dialect := PostgresDialect{commonsql.PostgresDialect{}}
+
+	mock.ExpectQuery(`AND kcu.table_schema = 'public'`).
+		WillReturnRows(sqlmock.NewRows([]string{"schema", "table", "keys"}).AddRow("public", "customer", "id"))
+	mock.ExpectQuery(`FROM information_schema.views\s+WHERE .*\s+AND table_schema = 'public'`).
+		WillReturnRows(sqlmock.NewRows([]string{"schema", "view"}).AddRow("public", "customer_list"))
+	mock.ExpectQuery(`AND kcu.table_schema = 'sales'`).
+		WillReturnRows(sqlmock.NewRows([]string{"schema", "table", "keys"}).AddRow("sales", "orders", "id,year"))
+	mock.ExpectQuery(`FROM information_schema.views\s+WHERE .*\s+AND table_schema = 'sales'`).
+		WillReturnRows(sqlmock.NewRows([]string{"schema", "view"}))
+
+	extractor := NewScopedSQLExtractor("", []string{"public", "sales"}, []table.ObjectType{table.ObjectTypeTable, table.ObjectTypeView}, dialect)
+
+	tables, e := extractor.extract(db, true, false)
+
+	assert.Nil(t, e)
+	assert.Equal(t, []table.Table{
+		{Name: "customer", Keys: []string{"id"}},
+		{Name: "customer_list", Type: table.ObjectTypeView, Keys: []string{}},
+		{Name: "sales.orders", Keys: []string{"id", "year"}},
+	}, tables)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExtractUnsupportedType(t *testing.T) {
+	t.Parallel()
+
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	extractor := NewScopedSQLExtractor("", []string{"app"}, []table.ObjectType{table.ObjectTypeSynonym}, MariadbDialect{commonsql.MariadbDialect{}})
+
+	_, e := extractor.extract(db, true, false)
+
+	assert.Equal(t, &table.Error{Description: "object type synonym is not supported by this database"}, e)
+}
@@ -37,9 +37,27 @@ type YAMLStructure struct {
 // YAMLTable defines how to store a table in YAML format.
 type YAMLTable struct {
 	Name       string       `yaml:"name"`
+	Type       string       `yaml:"type,omitempty"`
 	Keys       []string     `yaml:"keys"`
 	Columns    []YAMLColumn `yaml:"columns,omitempty"`
 	ExportMode string       `yaml:"export,omitempty"`
+	Version    string       `yaml:"version,omitempty"`
+	Comment    string       `yaml:"comment,omitempty"`
+	Indexes    []YAMLIndex  `yaml:"indexes,omitempty"`
+	Checks     []YAMLCheck  `yaml:"checks,omitempty"`
+}
+
+// YAMLIndex defines how to store an index in YAML format.
+type YAMLIndex struct {
+	Name    string   `yaml:"name"`
+	Columns []string `yaml:"columns"`
+	Unique  bool     `yaml:"unique,omitempty"`
+}
+
+// YAMLCheck defines how to store a check constraint in YAML format.
+type YAMLCheck struct {
+	Name       string `yaml:"name"`
+	Expression string `yaml:"check"`
 }
 
 // YAMLColumn defines how to store a column in YAML format.
@@ -53,11 +71,16 @@ type YAMLColumn struct {
 
 // YAMLDBInfo defines how to store a column dbinfos in YAML format.
 type YAMLDBInfo struct {
-	Type      string `yaml:"type"`
+	Type      string `yaml:"type,omitempty"`
 	Length    int64  `yaml:"length,omitempty"`
 	Size      int64  `yaml:"size,omitempty"`
 	Precision int64  `yaml:"precision,omitempty"`
 	ByteBased bool   `yaml:"bytes,omitempty"`
+	NotNull   bool   `yaml:"notnull,omitempty"`
+	Default   string `yaml:"default,omitempty"`
+	Comment   string `yaml:"comment,omitempty"`
+	Identity  bool   `yaml:"identity,omitempty"`
+	Generated string `yaml:"generated,omitempty"`
 }
 
 // YAMLStorage provides storage in a local YAML file
@@ -87,11 +110,26 @@ func (s YAMLStorage) List() ([]table.Table, *table.Error) {
 			exportMode = table.ExportModeAll
 		}
 
+		indexes := []table.Index{}
+		for _, ymi := range ym.Indexes {
+			indexes = append(indexes, table.Index(ymi))
+		}
+
+		checks := []table.Check{}
+		for _, ymk := range ym.Checks {
+			checks = append(checks, table.Check(ymk))
+		}
+
 		m := table.Table{
 			Name:       ym.Name,
+			Type:       table.ObjectType(ym.Type),
 			Keys:       ym.Keys,
 			Columns:    cols,
 			ExportMode: exportMode,
+			Version:    ym.Version,
+			Comment:    ym.Comment,
+			Indexes:    indexes,
+			Checks:     checks,
 		}
 		result = append(result, m)
 	}
@@ -111,10 +149,25 @@ func (s YAMLStorage) Store(tables []table.Table) *table.Error {
 			cols = append(cols, YAMLColumn{Name: rc.Name, Export: rc.Export, Import: rc.Import, DBInfo: YAMLDBInfo(rc.DBInfo)})
 		}
 
+		indexes := []YAMLIndex{}
+		for _, ri := range r.Indexes {
+			indexes = append(indexes, YAMLIndex(ri))
+		}
+
+		checks := []YAMLCheck{}
+		for _, rk := range r.Checks {
+			checks = append(checks, YAMLCheck(rk))
+		}
+
 		yml := YAMLTable{
 			Name:    r.Name,
+			Type:    string(r.Type),
 			Keys:    r.Keys,
 			Columns: cols,
+			Version: r.Version,
+			Comment: r.Comment,
+			Indexes: indexes,
+			Checks:  checks,
 		}
 
 		list.Tables = append(list.Tables, yml)
@@ -18,11 +18,13 @@
 package dataconnector
 
 // DataConnector holds a name (alias) and a URI to a database.
+// Schemas lists other schemas than the default Schema holding tables related to the tables of the default schema.
 type DataConnector struct {
 	Name     string
 	URL      string
 	ReadOnly bool
 	Schema   string
+	Schemas  []string
 	User     ValueHolder
 	Password ValueHolder
 }
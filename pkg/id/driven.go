@@ -32,3 +32,8 @@ type RelationReader interface {
 type Exporter interface {
 	Export(PullerPlan) *Error
 }
+
+// Filter selects the tables of a new ingress descriptor by name.
+type Filter interface {
+	Match(tableName string) bool
+}
@@ -25,26 +25,40 @@
 
 // Create and store ingress descriptor for the given start table and relation set.
 func Create(startTable string, selectColumns []string, relReader RelationReader, storage Storage) *Error {
+	return CreateWithOptions(startTable, selectColumns, relReader, storage, CreateOptions{})
+}
+
+// CreateWithOptions create ingress descriptor with the relations selected by the options
+func CreateWithOptions(startTable string, selectColumns []string, relReader RelationReader, storage Storage, options CreateOptions) *Error {
 	relations, err := relReader.Read()
 	if err != nil {
 		return err
 	}
 
+	excluded := func(t Table) bool {
+		return options.Filter != nil && t.Name() != startTable && !options.Filter.Match(t.Name())
+	}
+
 	ingressRels := []IngressRelation{}
 	for i := uint(0); i < relations.Len(); i++ {
 		rel := relations.Relation(i)
-		ingressRels = append(ingressRels, NewIngressRelation(rel, false, false, "", "", []string{}, []string{}))
+		if excluded(rel.Parent()) || excluded(rel.Child()) {
+			continue
+		}
+		ingressRels = append(ingressRels, NewIngressRelation(rel, false, false, "", "", []string{}, []string{}, []string{}, []string{}, 0))
 	}
 
 	fullGraph := newGraph(NewIngressRelationList(ingressRels))
 
-	connectedGraph, err := fullGraph.getConnectedGraph(startTable)
+	connectedGraph, err := fullGraph.getConnectedGraph(startTable, options.Direction, options.MaxDepth)
 	if err != nil {
 		return err
 	}
 
 	setLookUpChild := newSet()
+	pulled := []Table{}
 	if err := connectedGraph.visitChildren(startTable, func(t Table) {
+		pulled = append(pulled, t)
 		outgoingRelations := connectedGraph.relationsFrom(t)
 		for _, rel := range outgoingRelations {
 			setLookUpChild.add(rel.Name())
@@ -53,17 +67,20 @@ func Create(startTable string, selectColumns []string, relReader RelationReader,
 		return err
 	}
 
+	setLookUpParent := newSet()
+	if options.LookUpParents {
+		setLookUpParent = connectedGraph.parentLookups(pulled, setLookUpChild)
+	}
+
 	adrelations := []IngressRelation{}
 	for i := uint(0); i < connectedGraph.relations.Len(); i++ {
 		rel := connectedGraph.relations.Relation(i)
-		if setLookUpChild.contains(rel.Name()) {
-			adrelations = append(adrelations, NewIngressRelation(NewRelation(rel.Name(), rel.Parent(), rel.Child()), false, true, rel.WhereParent(), rel.WhereChild(), rel.SelectParent(), rel.SelectChild()))
-		} else {
-			adrelations = append(adrelations, NewIngressRelation(NewRelation(rel.Name(), rel.Parent(), rel.Child()), false, false, rel.WhereParent(), rel.WhereChild(), rel.SelectParent(), rel.SelectChild()))
-		}
+		lookUpParent := setLookUpParent.contains(rel.Name())
+		lookUpChild := setLookUpChild.contains(rel.Name())
+		adrelations = append(adrelations, NewIngressRelation(NewRelation(rel.Name(), rel.Parent(), rel.Child()), lookUpParent, lookUpChild, rel.WhereParent(), rel.WhereChild(), rel.SelectParent(), rel.SelectChild(), rel.OrderParent(), rel.OrderChild(), rel.LimitChild()))
 	}
 
-	id := NewIngressDescriptor(NewTable(startTable), selectColumns, NewIngressRelationList(adrelations))
+	id := NewIngressDescriptor(NewTable(startTable), selectColumns, []string{}, NewIngressRelationList(adrelations))
 
 	err = storage.Store(id)
 	if err != nil {
@@ -89,7 +106,7 @@ func SetStartTable(table Table, storage Storage) *Error {
 		return &Error{Description: fmt.Sprintf("Table %s doesn't exist", table.Name())}
 	}
 
-	updatedID := NewIngressDescriptor(table, id.Select(), id.Relations())
+	updatedID := NewIngressDescriptor(table, id.Select(), id.OrderBy(), id.Relations())
 
 	err = storage.Store(updatedID)
 	if err != nil {
@@ -114,12 +131,12 @@ func SetChildLookup(relation string, flag bool, storage Storage) *Error {
 	for i := uint(0); i < id.Relations().Len(); i++ {
 		rel := id.Relations().Relation(i)
 		if rel.Name() == relation {
-			rel = NewIngressRelation(NewRelation(rel.Name(), rel.Parent(), rel.Child()), rel.LookUpParent(), flag, rel.WhereParent(), rel.WhereChild(), rel.SelectParent(), rel.SelectChild())
+			rel = NewIngressRelation(NewRelation(rel.Name(), rel.Parent(), rel.Child()), rel.LookUpParent(), flag, rel.WhereParent(), rel.WhereChild(), rel.SelectParent(), rel.SelectChild(), rel.OrderParent(), rel.OrderChild(), rel.LimitChild())
 		}
 		relations[i] = rel
 	}
 
-	updatedID := NewIngressDescriptor(id.StartTable(), id.Select(), NewIngressRelationList(relations))
+	updatedID := NewIngressDescriptor(id.StartTable(), id.Select(), id.OrderBy(), NewIngressRelationList(relations))
 
 	err = storage.Store(updatedID)
 	if err != nil {
@@ -144,12 +161,12 @@ func SetParentLookup(relation string, flag bool, storage Storage) *Error {
 	for i := uint(0); i < id.Relations().Len(); i++ {
 		rel := id.Relations().Relation(i)
 		if rel.Name() == relation {
-			rel = NewIngressRelation(NewRelation(rel.Name(), rel.Parent(), rel.Child()), flag, rel.LookUpChild(), rel.WhereParent(), rel.WhereChild(), rel.SelectParent(), rel.SelectChild())
+			rel = NewIngressRelation(NewRelation(rel.Name(), rel.Parent(), rel.Child()), flag, rel.LookUpChild(), rel.WhereParent(), rel.WhereChild(), rel.SelectParent(), rel.SelectChild(), rel.OrderParent(), rel.OrderChild(), rel.LimitChild())
 		}
 		relations[i] = rel
 	}
 
-	updatedID := NewIngressDescriptor(id.StartTable(), id.Select(), NewIngressRelationList(relations))
+	updatedID := NewIngressDescriptor(id.StartTable(), id.Select(), id.OrderBy(), NewIngressRelationList(relations))
 
 	err = storage.Store(updatedID)
 	if err != nil {
@@ -174,12 +191,12 @@ func SetChildWhere(relation string, where string, storage Storage) *Error {
 	for i := uint(0); i < id.Relations().Len(); i++ {
 		rel := id.Relations().Relation(i)
 		if rel.Name() == relation {
-			rel = NewIngressRelation(NewRelation(rel.Name(), rel.Parent(), rel.Child()), rel.LookUpParent(), rel.LookUpChild(), rel.WhereParent(), where, rel.SelectParent(), rel.SelectChild())
+			rel = NewIngressRelation(NewRelation(rel.Name(), rel.Parent(), rel.Child()), rel.LookUpParent(), rel.LookUpChild(), rel.WhereParent(), where, rel.SelectParent(), rel.SelectChild(), rel.OrderParent(), rel.OrderChild(), rel.LimitChild())
 		}
 		relations[i] = rel
 	}
 
-	updatedID := NewIngressDescriptor(id.StartTable(), id.Select(), NewIngressRelationList(relations))
+	updatedID := NewIngressDescriptor(id.StartTable(), id.Select(), id.OrderBy(), NewIngressRelationList(relations))
 
 	err = storage.Store(updatedID)
 	if err != nil {
@@ -204,12 +221,12 @@ func SetChildSelect(relation string, columns []string, storage Storage) *Error {
 	for i := uint(0); i < id.Relations().Len(); i++ {
 		rel := id.Relations().Relation(i)
 		if rel.Name() == relation {
-			rel = NewIngressRelation(NewRelation(rel.Name(), rel.Parent(), rel.Child()), rel.LookUpParent(), rel.LookUpChild(), rel.WhereParent(), rel.WhereChild(), rel.SelectParent(), columns)
+			rel = NewIngressRelation(NewRelation(rel.Name(), rel.Parent(), rel.Child()), rel.LookUpParent(), rel.LookUpChild(), rel.WhereParent(), rel.WhereChild(), rel.SelectParent(), columns, rel.OrderParent(), rel.OrderChild(), rel.LimitChild())
 		}
 		relations[i] = rel
 	}
 
-	updatedID := NewIngressDescriptor(id.StartTable(), id.Select(), NewIngressRelationList(relations))
+	updatedID := NewIngressDescriptor(id.StartTable(), id.Select(), id.OrderBy(), NewIngressRelationList(relations))
 
 	err = storage.Store(updatedID)
 	if err != nil {
@@ -234,12 +251,12 @@ func SetParentWhere(relation string, where string, storage Storage) *Error {
 	for i := uint(0); i < id.Relations().Len(); i++ {
 		rel := id.Relations().Relation(i)
 		if rel.Name() == relation {
-			rel = NewIngressRelation(NewRelation(rel.Name(), rel.Parent(), rel.Child()), rel.LookUpParent(), rel.LookUpChild(), where, rel.WhereChild(), rel.SelectParent(), rel.SelectChild())
+			rel = NewIngressRelation(NewRelation(rel.Name(), rel.Parent(), rel.Child()), rel.LookUpParent(), rel.LookUpChild(), where, rel.WhereChild(), rel.SelectParent(), rel.SelectChild(), rel.OrderParent(), rel.OrderChild(), rel.LimitChild())
 		}
 		relations[i] = rel
 	}
 
-	updatedID := NewIngressDescriptor(id.StartTable(), id.Select(), NewIngressRelationList(relations))
+	updatedID := NewIngressDescriptor(id.StartTable(), id.Select(), id.OrderBy(), NewIngressRelationList(relations))
 
 	err = storage.Store(updatedID)
 	if err != nil {
@@ -264,12 +281,102 @@ func SetParentSelect(relation string, columns []string, storage Storage) *Error
 	for i := uint(0); i < id.Relations().Len(); i++ {
 		rel := id.Relations().Relation(i)
 		if rel.Name() == relation {
-			rel = NewIngressRelation(NewRelation(rel.Name(), rel.Parent(), rel.Child()), rel.LookUpParent(), rel.LookUpChild(), rel.WhereParent(), rel.WhereChild(), columns, rel.SelectChild())
+			rel = NewIngressRelation(NewRelation(rel.Name(), rel.Parent(), rel.Child()), rel.LookUpParent(), rel.LookUpChild(), rel.WhereParent(), rel.WhereChild(), columns, rel.SelectChild(), rel.OrderParent(), rel.OrderChild(), rel.LimitChild())
+		}
+		relations[i] = rel
+	}
+
+	updatedID := NewIngressDescriptor(id.StartTable(), id.Select(), id.OrderBy(), NewIngressRelationList(relations))
+
+	err = storage.Store(updatedID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// SetChildOrder update child order relation's parameter in ingress descriptor
+func SetChildOrder(relation string, columns []string, storage Storage) *Error {
+	id, err := storage.Read()
+	if err != nil {
+		return err
+	}
+
+	if !id.Relations().Contains(relation) {
+		return &Error{Description: fmt.Sprintf("Relation %s doesn't exist", relation)}
+	}
+
+	relations := make([]IngressRelation, id.Relations().Len())
+
+	for i := uint(0); i < id.Relations().Len(); i++ {
+		rel := id.Relations().Relation(i)
+		if rel.Name() == relation {
+			rel = NewIngressRelation(NewRelation(rel.Name(), rel.Parent(), rel.Child()), rel.LookUpParent(), rel.LookUpChild(), rel.WhereParent(), rel.WhereChild(), rel.SelectParent(), rel.SelectChild(), rel.OrderParent(), columns, rel.LimitChild())
+		}
+		relations[i] = rel
+	}
+
+	updatedID := NewIngressDescriptor(id.StartTable(), id.Select(), id.OrderBy(), NewIngressRelationList(relations))
+
+	err = storage.Store(updatedID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// SetChildLimit update child limit relation's parameter in ingress descriptor
+func SetChildLimit(relation string, limit uint, storage Storage) *Error {
+	id, err := storage.Read()
+	if err != nil {
+		return err
+	}
+
+	if !id.Relations().Contains(relation) {
+		return &Error{Description: fmt.Sprintf("Relation %s doesn't exist", relation)}
+	}
+
+	relations := make([]IngressRelation, id.Relations().Len())
+
+	for i := uint(0); i < id.Relations().Len(); i++ {
+		rel := id.Relations().Relation(i)
+		if rel.Name() == relation {
+			rel = NewIngressRelation(NewRelation(rel.Name(), rel.Parent(), rel.Child()), rel.LookUpParent(), rel.LookUpChild(), rel.WhereParent(), rel.WhereChild(), rel.SelectParent(), rel.SelectChild(), rel.OrderParent(), rel.OrderChild(), limit)
+		}
+		relations[i] = rel
+	}
+
+	updatedID := NewIngressDescriptor(id.StartTable(), id.Select(), id.OrderBy(), NewIngressRelationList(relations))
+
+	err = storage.Store(updatedID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// SetParentOrder update parent order relation's parameter in ingress descriptor
+func SetParentOrder(relation string, columns []string, storage Storage) *Error {
+	id, err := storage.Read()
+	if err != nil {
+		return err
+	}
+
+	if !id.Relations().Contains(relation) {
+		return &Error{Description: fmt.Sprintf("Relation %s doesn't exist", relation)}
+	}
+
+	relations := make([]IngressRelation, id.Relations().Len())
+
+	for i := uint(0); i < id.Relations().Len(); i++ {
+		rel := id.Relations().Relation(i)
+		if rel.Name() == relation {
+			rel = NewIngressRelation(NewRelation(rel.Name(), rel.Parent(), rel.Child()), rel.LookUpParent(), rel.LookUpChild(), rel.WhereParent(), rel.WhereChild(), rel.SelectParent(), rel.SelectChild(), columns, rel.OrderChild(), rel.LimitChild())
 		}
 		relations[i] = rel
 	}
 
-	updatedID := NewIngressDescriptor(id.StartTable(), id.Select(), NewIngressRelationList(relations))
+	updatedID := NewIngressDescriptor(id.StartTable(), id.Select(), id.OrderBy(), NewIngressRelationList(relations))
 
 	err = storage.Store(updatedID)
 	if err != nil {
@@ -309,7 +416,7 @@ func GetPullerPlan(storage Storage) (PullerPlan, *Error) {
 		startRelationsList = sg.relations
 	}
 	steps := []Step{
-		NewStep(1, id.StartTable(), NewIngressRelation(NewRelation("", nil, nil), false, false, "", "", []string{}, []string{}), startRelationsList, startTableList, startCycles, 0),
+		NewStep(1, id.StartTable(), NewIngressRelation(NewRelation("", nil, nil), false, false, "", "", []string{}, []string{}, []string{}, []string{}, 0), startRelationsList, startTableList, startCycles, 0),
 	}
 	log.Debug().Msg(fmt.Sprintf("%v", steps[0]))
 
@@ -325,7 +432,7 @@ func GetPullerPlan(storage Storage) (PullerPlan, *Error) {
 		log.Warn().Msg(err.Error())
 	}
 
-	return NewPullerPlan(steps, g.relations, g.tables, id.Select()), nil
+	return NewPullerPlan(steps, g.relations, g.tables, id.Select(), id.OrderBy()), nil
 }
 
 // Export the puller plan.
@@ -0,0 +1,329 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+package id
+
+import (
+	"fmt"
+	"strings"
+)
+
+// relationField is a value of an ingress relation compared by Diff and merged by Merge.
+type relationField struct {
+	name  string
+	value func(IngressRelation) interface{}
+}
+
+var relationFields = []relationField{
+	{"parent.name", func(r IngressRelation) interface{} { return r.Parent().Name() }},
+	{"parent.lookup", func(r IngressRelation) interface{} { return r.LookUpParent() }},
+	{"parent.where", func(r IngressRelation) interface{} { return r.WhereParent() }},
+	{"parent.select", func(r IngressRelation) interface{} { return r.SelectParent() }},
+	{"parent.orderBy", func(r IngressRelation) interface{} { return r.OrderParent() }},
+	{"child.name", func(r IngressRelation) interface{} { return r.Child().Name() }},
+	{"child.lookup", func(r IngressRelation) interface{} { return r.LookUpChild() }},
+	{"child.where", func(r IngressRelation) interface{} { return r.WhereChild() }},
+	{"child.select", func(r IngressRelation) interface{} { return r.SelectChild() }},
+	{"child.orderBy", func(r IngressRelation) interface{} { return r.OrderChild() }},
+	{"child.limit", func(r IngressRelation) interface{} { return r.LimitChild() }},
+}
+
+// relationValues returns the values of the relation in the order of relationFields, or nil values if the relation is
+// nil.
+func relationValues(r IngressRelation) []interface{} {
+	values := make([]interface{}, len(relationFields))
+	if r != nil {
+		for i, field := range relationFields {
+			values[i] = field.value(r)
+		}
+	}
+	return values
+}
+
+func newRelationFromValues(name string, values []interface{}) IngressRelation {
+	return NewIngressRelation(
+		NewRelation(name, NewTable(values[0].(string)), NewTable(values[5].(string))),
+		values[1].(bool), values[6].(bool),
+		values[2].(string), values[7].(string),
+		values[3].([]string), values[8].([]string),
+		values[4].([]string), values[9].([]string),
+		values[10].(uint),
+	)
+}
+
+// Diff compares the ingress descriptors of storages a and b, and their puller plans.
+func Diff(a, b Storage) (Differences, *Error) {
+	descA, err := a.Read()
+	if err != nil {
+		return Differences{}, err
+	}
+
+	descB, err := b.Read()
+	if err != nil {
+		return Differences{}, err
+	}
+
+	changes := []Change{}
+	compare := func(path string, before, after interface{}) {
+		if !sameValue(before, after) {
+			changes = append(changes, Change{Path: path, Before: formatValue(before), After: formatValue(after)})
+		}
+	}
+
+	compare("startTable", descA.StartTable().Name(), descB.StartTable().Name())
+	compare("select", descA.Select(), descB.Select())
+	compare("orderBy", descA.OrderBy(), descB.OrderBy())
+
+	relationsA, relationsB := relationsByName(descA.Relations()), relationsByName(descB.Relations())
+	for _, name := range relationNames(descA.Relations(), descB.Relations()) {
+		relA, relB := relationsA[name], relationsB[name]
+		switch {
+		case relB == nil:
+			changes = append(changes, Change{Path: "relations." + name, Before: relationSummary(relA)})
+		case relA == nil:
+			changes = append(changes, Change{Path: "relations." + name, After: relationSummary(relB)})
+		default:
+			valuesA, valuesB := relationValues(relA), relationValues(relB)
+			for i, field := range relationFields {
+				compare("relations."+name+"."+field.name, valuesA[i], valuesB[i])
+			}
+		}
+	}
+
+	planA, err := GetPullerPlan(a)
+	if err != nil {
+		return Differences{}, err
+	}
+
+	planB, err := GetPullerPlan(b)
+	if err != nil {
+		return Differences{}, err
+	}
+
+	return Differences{Changes: changes, Steps: diffSteps(stepStrings(planA), stepStrings(planB))}, nil
+}
+
+// Merge the changes of the ingress descriptors ours and theirs made from the ingress descriptor base, and store the
+// result. A value changed differently by ours and theirs is a conflict, the result keeps the value of ours.
+func Merge(base, ours, theirs, result Storage) ([]Conflict, *Error) {
+	descBase, err := base.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	descOurs, err := ours.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	descTheirs, err := theirs.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	conflicts := []Conflict{}
+	merge := func(path string, b, o, t interface{}) interface{} {
+		switch {
+		case sameValue(o, t):
+			return o
+		case sameValue(b, o):
+			return t
+		case sameValue(b, t):
+			return o
+		}
+		conflicts = append(conflicts, Conflict{Path: path, Base: formatValue(b), Ours: formatValue(o), Theirs: formatValue(t)})
+		return o
+	}
+
+	startTable := merge("startTable", descBase.StartTable().Name(), descOurs.StartTable().Name(), descTheirs.StartTable().Name()).(string)
+	selectColumns := merge("select", descBase.Select(), descOurs.Select(), descTheirs.Select()).([]string)
+	orderBy := merge("orderBy", descBase.OrderBy(), descOurs.OrderBy(), descTheirs.OrderBy()).([]string)
+
+	relationsBase := relationsByName(descBase.Relations())
+	relationsOurs := relationsByName(descOurs.Relations())
+	relationsTheirs := relationsByName(descTheirs.Relations())
+
+	relations := []IngressRelation{}
+	for _, name := range relationNames(descBase.Relations(), descOurs.Relations(), descTheirs.Relations()) {
+		relBase, relOurs, relTheirs := relationsBase[name], relationsOurs[name], relationsTheirs[name]
+
+		switch {
+		case relOurs == nil && relTheirs == nil:
+			continue
+		case relOurs == nil || relTheirs == nil:
+			present := relOurs
+			if present == nil {
+				present = relTheirs
+			}
+			if relBase == nil {
+				// added by one side only
+				relations = append(relations, present)
+				continue
+			}
+			if sameValue(relationValues(relBase), relationValues(present)) {
+				// removed by one side, unchanged by the other
+				continue
+			}
+			conflicts = append(conflicts, Conflict{
+				Path:   "relations." + name,
+				Base:   relationSummary(relBase),
+				Ours:   relationSummary(relOurs),
+				Theirs: relationSummary(relTheirs),
+			})
+			relations = append(relations, present)
+		default:
+			valuesBase, valuesOurs, valuesTheirs := relationValues(relBase), relationValues(relOurs), relationValues(relTheirs)
+			values := make([]interface{}, len(relationFields))
+			for i, field := range relationFields {
+				values[i] = merge("relations."+name+"."+field.name, valuesBase[i], valuesOurs[i], valuesTheirs[i])
+			}
+			relations = append(relations, newRelationFromValues(name, values))
+		}
+	}
+
+	merged := NewIngressDescriptor(NewTable(startTable), selectColumns, orderBy, NewIngressRelationList(relations))
+	if err := result.Store(merged); err != nil {
+		return nil, err
+	}
+
+	return conflicts, nil
+}
+
+func relationsByName(list IngressRelationList) map[string]IngressRelation {
+	result := map[string]IngressRelation{}
+	for i := uint(0); i < list.Len(); i++ {
+		result[list.Relation(i).Name()] = list.Relation(i)
+	}
+	return result
+}
+
+// relationNames returns the names of the relations of all lists, in the order of the first list then of the next
+// lists for the relations missing in the previous ones.
+func relationNames(lists ...IngressRelationList) []string {
+	result := []string{}
+	seen := newSet()
+	for _, list := range lists {
+		for i := uint(0); i < list.Len(); i++ {
+			if name := list.Relation(i).Name(); !seen.contains(name) {
+				seen.add(name)
+				result = append(result, name)
+			}
+		}
+	}
+	return result
+}
+
+func relationSummary(r IngressRelation) string {
+	if r == nil {
+		return ""
+	}
+	return r.Parent().Name() + " -> " + r.Child().Name()
+}
+
+// sameValue compares values of relationFields, a nil or empty list are the same value.
+func sameValue(a, b interface{}) bool {
+	switch va := a.(type) {
+	case []string:
+		vb, ok := b.([]string)
+		if !ok {
+			return len(va) == 0 && b == nil
+		}
+		if len(va) != len(vb) {
+			return false
+		}
+		for i := range va {
+			if va[i] != vb[i] {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		vb, ok := b.([]interface{})
+		if !ok || len(va) != len(vb) {
+			return false
+		}
+		for i := range va {
+			if !sameValue(va[i], vb[i]) {
+				return false
+			}
+		}
+		return true
+	case nil:
+		if vb, ok := b.([]string); ok {
+			return len(vb) == 0
+		}
+		return b == nil
+	}
+	return a == b
+}
+
+func formatValue(v interface{}) string {
+	switch value := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return fmt.Sprintf("%q", value)
+	case []string:
+		quoted := make([]string, 0, len(value))
+		for _, s := range value {
+			quoted = append(quoted, fmt.Sprintf("%q", s))
+		}
+		return "[" + strings.Join(quoted, ", ") + "]"
+	}
+	return fmt.Sprint(v)
+}
+
+func stepStrings(plan PullerPlan) []string {
+	result := []string{}
+	for i := uint(0); i < plan.Len(); i++ {
+		result = append(result, plan.Step(i).String())
+	}
+	return result
+}
+
+// diffSteps returns the steps removed from a and added in b, in the order of the longest common subsequence of steps.
+func diffSteps(a, b []string) []StepChange {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	result := []StepChange{}
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			i++
+			j++
+		case j == len(b) || (i < len(a) && lcs[i+1][j] >= lcs[i][j+1]):
+			result = append(result, StepChange{Added: false, Step: a[i]})
+			i++
+		default:
+			result = append(result, StepChange{Added: true, Step: b[j]})
+			j++
+		}
+	}
+	return result
+}
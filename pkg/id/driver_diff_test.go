@@ -0,0 +1,91 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+package id_test
+
+import (
+	"testing"
+
+	"github.com/cgi-fr/lino/pkg/id"
+	"github.com/stretchr/testify/assert"
+)
+
+func descriptor(selectColumns []string, relations ...id.IngressRelation) *MemoryStorage {
+	return &MemoryStorage{id: id.NewIngressDescriptor(id.NewTable("A"), selectColumns, []string{}, id.NewIngressRelationList(relations))}
+}
+
+func whereChild(relation string, where string) id.IngressRelation {
+	rel := relationString(relation)
+	return id.NewIngressRelation(rel, false, true, "", where, []string{}, []string{}, []string{}, []string{}, 0)
+}
+
+func TestDiff(t *testing.T) {
+	a := descriptor([]string{}, adRelationString("A->B", false, true), adRelationString("B->C", false, false), adRelationString("Z->A", false, false))
+	b := descriptor([]string{"id"}, whereChild("A->B", "id > 10"), adRelationString("B->C", false, true), adRelationString("Y->A", false, false))
+
+	diff, err := id.Diff(a, b)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []id.Change{
+		{Path: "select", Before: "[]", After: `["id"]`},
+		{Path: "relations.A_B.child.where", Before: `""`, After: `"id > 10"`},
+		{Path: "relations.B_C.child.lookup", Before: "false", After: "true"},
+		{Path: "relations.Z_A", Before: "Z -> A"},
+		{Path: "relations.Y_A", After: "Y -> A"},
+	}, diff.Changes)
+	assert.Equal(t, []id.StepChange{
+		{Added: true, Step: "step 3 - pull rows from C following →B_C relationship for rows pulled at step 2"},
+	}, diff.Steps)
+}
+
+func TestDiffSame(t *testing.T) {
+	a := descriptor([]string{}, adRelationString("A->B", false, true))
+
+	diff, err := id.Diff(a, a)
+
+	assert.Nil(t, err)
+	assert.Empty(t, diff.Changes)
+	assert.Empty(t, diff.Steps)
+}
+
+func TestMerge(t *testing.T) {
+	base := descriptor([]string{}, adRelationString("A->B", false, true), adRelationString("B->C", false, false), adRelationString("Z->A", false, false))
+	ours := descriptor([]string{"id"}, adRelationString("A->B", false, true), adRelationString("B->C", false, true))
+	theirs := descriptor([]string{}, whereChild("A->B", "id > 10"), adRelationString("B->C", false, false), adRelationString("Z->A", false, false), adRelationString("Y->A", false, false))
+	result := &MemoryStorage{}
+
+	conflicts, err := id.Merge(base, ours, theirs, result)
+
+	assert.Nil(t, err)
+	assert.Empty(t, conflicts)
+	assert.Equal(t, descriptor([]string{"id"}, whereChild("A->B", "id > 10"), adRelationString("B->C", false, true), adRelationString("Y->A", false, false)).id, result.id)
+}
+
+func TestMergeConflicts(t *testing.T) {
+	base := descriptor([]string{}, adRelationString("A->B", false, true), adRelationString("Z->A", false, false))
+	ours := descriptor([]string{}, whereChild("A->B", "id > 10"))
+	theirs := descriptor([]string{}, whereChild("A->B", "id < 10"), adRelationString("Z->A", true, false))
+	result := &MemoryStorage{}
+
+	conflicts, err := id.Merge(base, ours, theirs, result)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []id.Conflict{
+		{Path: "relations.A_B.child.where", Base: `""`, Ours: `"id > 10"`, Theirs: `"id < 10"`},
+		{Path: "relations.Z_A", Base: "Z -> A", Ours: "", Theirs: "Z -> A"},
+	}, conflicts)
+	assert.Equal(t, descriptor([]string{}, whereChild("A->B", "id > 10"), adRelationString("Z->A", true, false)).id, result.id)
+}
@@ -0,0 +1,147 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package id
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+// paramPattern matches ${name} or ${name:-default value}.
+var paramPattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)(:-([^}]*))?\}`)
+
+// ParseParams reads parameters given as key=value.
+func ParseParams(values []string) (map[string]string, *Error) {
+	params := map[string]string{}
+	for _, value := range values {
+		name, paramValue, ok := strings.Cut(value, "=")
+		if !ok || !paramPattern.MatchString("${"+name+"}") {
+			return nil, &Error{Description: fmt.Sprintf("invalid parameter %s, expected key=value", value)}
+		}
+		params[name] = paramValue
+	}
+	return params, nil
+}
+
+// WithParams returns a storage reading the ingress descriptor of storage with its parameters resolved, the resolved
+// ingress descriptor can't be stored.
+func WithParams(storage Storage, params map[string]string) Storage {
+	return paramStorage{storage: storage, params: params}
+}
+
+type paramStorage struct {
+	storage Storage
+	params  map[string]string
+}
+
+func (s paramStorage) Read() (IngressDescriptor, *Error) {
+	ingressDescriptor, err := s.storage.Read()
+	if err != nil {
+		return nil, err
+	}
+	return ResolveParams(ingressDescriptor, s.params)
+}
+
+func (s paramStorage) Store(IngressDescriptor) *Error {
+	return &Error{Description: "an ingress descriptor with resolved parameters can't be stored"}
+}
+
+// ResolveParams replaces the parameters ${name} in the select and where clauses of the ingress descriptor by their
+// values, a parameter without value is replaced by its default value (${name:-default}) or is an error.
+func ResolveParams(ingressDescriptor IngressDescriptor, params map[string]string) (IngressDescriptor, *Error) {
+	r := resolver{params: params, undefined: map[string]bool{}, used: map[string]bool{}}
+
+	relations := []IngressRelation{}
+	list := ingressDescriptor.Relations()
+	for i := uint(0); i < list.Len(); i++ {
+		rel := list.Relation(i)
+		relations = append(relations, NewIngressRelation(
+			NewRelation(rel.Name(), rel.Parent(), rel.Child()),
+			rel.LookUpParent(), rel.LookUpChild(),
+			r.resolve(rel.WhereParent()), r.resolve(rel.WhereChild()),
+			r.resolveAll(rel.SelectParent()), r.resolveAll(rel.SelectChild()),
+			rel.OrderParent(), rel.OrderChild(),
+			rel.LimitChild(),
+		))
+	}
+
+	resolved := NewIngressDescriptor(
+		ingressDescriptor.StartTable(),
+		r.resolveAll(ingressDescriptor.Select()),
+		ingressDescriptor.OrderBy(),
+		NewIngressRelationList(relations),
+	)
+
+	if len(r.undefined) > 0 {
+		names := make([]string, 0, len(r.undefined))
+		for name := range r.undefined {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, &Error{Description: fmt.Sprintf("undefined parameters in ingress descriptor: %s", strings.Join(names, ", "))}
+	}
+
+	for name := range params {
+		if !r.used[name] {
+			log.Warn().Str("param", name).Msg("parameter is not used by the ingress descriptor")
+		}
+	}
+
+	return resolved, nil
+}
+
+type resolver struct {
+	params    map[string]string
+	undefined map[string]bool
+	used      map[string]bool
+}
+
+func (r resolver) resolve(value string) string {
+	return paramPattern.ReplaceAllStringFunc(value, func(param string) string {
+		groups := paramPattern.FindStringSubmatch(param)
+		name := groups[1]
+
+		if value, ok := r.params[name]; ok {
+			r.used[name] = true
+			return value
+		}
+
+		if groups[2] != "" {
+			return groups[3]
+		}
+
+		r.undefined[name] = true
+		return param
+	})
+}
+
+func (r resolver) resolveAll(values []string) []string {
+	if values == nil {
+		return nil
+	}
+
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		result = append(result, r.resolve(value))
+	}
+	return result
+}
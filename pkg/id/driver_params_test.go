@@ -0,0 +1,59 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package id_test
+
+import (
+	"testing"
+
+	"github.com/cgi-fr/lino/pkg/id"
+	"github.com/stretchr/testify/assert"
+)
+
+func paramsDescriptor() id.IngressDescriptor {
+	return id.NewIngressDescriptor(
+		id.NewTable("customer"),
+		[]string{"customer_id", "${extra:-email}"},
+		[]string{"customer_id"},
+		id.NewIngressRelationList([]id.IngressRelation{
+			id.NewIngressRelation(
+				id.NewRelation("rental_customer", id.NewTable("customer"), id.NewTable("rental")),
+				false, true,
+				"", "rental_date >= '${since}' AND rental_date < '${until:-2006-01-01}'",
+				nil, []string{"rental_id"},
+				nil, nil,
+				0,
+			),
+		}),
+	)
+}
+
+func TestResolveParams(t *testing.T) {
+	resolved, err := id.ResolveParams(paramsDescriptor(), map[string]string{"since": "2005-06-01", "extra": "last_name"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"customer_id", "last_name"}, resolved.Select())
+	assert.Equal(t, "rental_date >= '2005-06-01' AND rental_date < '2006-01-01'", resolved.Relations().Relation(0).WhereChild())
+	assert.Equal(t, []string{"rental_id"}, resolved.Relations().Relation(0).SelectChild())
+	assert.True(t, resolved.Relations().Relation(0).LookUpChild())
+}
+
+func TestResolveUndefinedParams(t *testing.T) {
+	_, err := id.ResolveParams(paramsDescriptor(), map[string]string{})
+
+	assert.Equal(t, &id.Error{Description: "undefined parameters in ingress descriptor: since"}, err)
+}
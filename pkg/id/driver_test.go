@@ -65,7 +65,7 @@ func relationString(relation string) id.Relation {
 
 // relation help to create id.Relation object from a string representation `parent -> child`.
 func adRelationString(relation string, lookupParent bool, lookupChild bool) id.IngressRelation {
-	return id.NewIngressRelation(relationString(relation), lookupParent, lookupChild, "", "", []string{}, []string{})
+	return id.NewIngressRelation(relationString(relation), lookupParent, lookupChild, "", "", []string{}, []string{}, []string{}, []string{}, 0)
 }
 
 var adCreateTests = []struct {
@@ -81,6 +81,7 @@ func adRelationString(relation string, lookupParent bool, lookupChild bool) id.I
 		id.NewIngressDescriptor(
 			id.NewTable("A"),
 			[]string{},
+			[]string{},
 			id.NewIngressRelationList([]id.IngressRelation{
 				adRelationString("B->A", false, false),
 			}),
@@ -100,6 +101,7 @@ func adRelationString(relation string, lookupParent bool, lookupChild bool) id.I
 		id.NewIngressDescriptor(
 			id.NewTable("A"),
 			[]string{},
+			[]string{},
 			id.NewIngressRelationList([]id.IngressRelation{
 				adRelationString("A->B", false, true),
 				adRelationString("B->C", false, true),
@@ -124,6 +126,7 @@ func adRelationString(relation string, lookupParent bool, lookupChild bool) id.I
 		id.NewIngressDescriptor(
 			id.NewTable("A"),
 			[]string{},
+			[]string{},
 			id.NewIngressRelationList([]id.IngressRelation{
 				adRelationString("A->B", false, true),
 				adRelationString("B->C", false, true),
@@ -153,12 +156,102 @@ func TestCreate(t *testing.T) {
 	}
 }
 
+// excludeFilter rejects the listed tables.
+type excludeFilter []string
+
+func (f excludeFilter) Match(tableName string) bool {
+	for _, excluded := range f {
+		if excluded == tableName {
+			return false
+		}
+	}
+	return true
+}
+
+var adCreateWithOptionsRelations = id.NewRelationList([]id.Relation{
+	relationString("A->B"),
+	relationString("B->C"),
+	relationString("C->D"),
+	relationString("Z->A"),
+	relationString("Y->D"),
+	relationString("Y->X"),
+})
+
+var adCreateWithOptionsTests = []struct {
+	name      string
+	options   id.CreateOptions
+	relations []id.IngressRelation
+}{
+	{
+		"max depth",
+		id.CreateOptions{MaxDepth: 1},
+		[]id.IngressRelation{
+			adRelationString("A->B", false, true),
+			adRelationString("Z->A", false, false),
+		},
+	},
+	{
+		"children only",
+		id.CreateOptions{Direction: id.DirectionChildren},
+		[]id.IngressRelation{
+			adRelationString("A->B", false, true),
+			adRelationString("B->C", false, true),
+			adRelationString("C->D", false, true),
+		},
+	},
+	{
+		"parents only",
+		id.CreateOptions{Direction: id.DirectionParents},
+		[]id.IngressRelation{
+			adRelationString("Z->A", false, false),
+		},
+	},
+	{
+		"exclude",
+		id.CreateOptions{Filter: excludeFilter{"C"}},
+		[]id.IngressRelation{
+			adRelationString("A->B", false, true),
+			adRelationString("Z->A", false, false),
+		},
+	},
+	{
+		"look up parents",
+		id.CreateOptions{LookUpParents: true},
+		[]id.IngressRelation{
+			adRelationString("A->B", false, true),
+			adRelationString("B->C", false, true),
+			adRelationString("C->D", false, true),
+			adRelationString("Z->A", true, false),
+			adRelationString("Y->D", true, false),
+			adRelationString("Y->X", false, false),
+		},
+	},
+}
+
+func TestCreateWithOptions(t *testing.T) {
+	for _, tt := range adCreateWithOptionsTests {
+		t.Run(tt.name, func(t *testing.T) {
+			relReader := &MockRelationReader{
+				fn: func() (id.RelationList, *id.Error) {
+					return adCreateWithOptionsRelations, nil
+				},
+			}
+			storage := &MemoryStorage{}
+
+			err := id.CreateWithOptions("A", []string{}, relReader, storage, tt.options)
+
+			assert.Nil(t, err)
+			assert.Equal(t, id.NewIngressDescriptor(id.NewTable("A"), []string{}, []string{}, id.NewIngressRelationList(tt.relations)), storage.id)
+		})
+	}
+}
+
 func newInitialStep(tableName string) id.Step {
 	table := id.NewTable(tableName)
 	return id.NewStep(
 		1,
 		table,
-		id.NewIngressRelation(id.NewRelation("", nil, nil), false, false, "", "", []string{}, []string{}),
+		id.NewIngressRelation(id.NewRelation("", nil, nil), false, false, "", "", []string{}, []string{}, []string{}, []string{}, 0),
 		id.NewIngressRelationList([]id.IngressRelation{}),
 		id.NewTableList([]id.Table{table}),
 		id.NewCycleList([]id.IngressRelationList{}),
@@ -204,6 +297,7 @@ func newSimpleStep(index uint, tableName string, relation string, lookupParent b
 		id.NewIngressDescriptor(
 			id.NewTable("A"),
 			[]string{},
+			[]string{},
 			id.NewIngressRelationList([]id.IngressRelation{
 				adRelationString("A->B", false, true),
 			}),
@@ -218,6 +312,7 @@ func newSimpleStep(index uint, tableName string, relation string, lookupParent b
 		id.NewIngressDescriptor(
 			id.NewTable("I"),
 			[]string{},
+			[]string{},
 			id.NewIngressRelationList([]id.IngressRelation{
 				adRelationString("C->O", false, true),
 				adRelationString("O->D", false, true),
@@ -233,6 +328,7 @@ func newSimpleStep(index uint, tableName string, relation string, lookupParent b
 		id.NewIngressDescriptor(
 			id.NewTable("I"),
 			[]string{},
+			[]string{},
 			id.NewIngressRelationList([]id.IngressRelation{
 				adRelationString("C->O", false, true),
 				adRelationString("O->D", false, true),
@@ -248,6 +344,7 @@ func newSimpleStep(index uint, tableName string, relation string, lookupParent b
 		id.NewIngressDescriptor(
 			id.NewTable("C"),
 			[]string{},
+			[]string{},
 			id.NewIngressRelationList([]id.IngressRelation{
 				adRelationString("C->O", false, true),
 				adRelationString("O->D", false, true),
@@ -264,6 +361,7 @@ func newSimpleStep(index uint, tableName string, relation string, lookupParent b
 		id.NewIngressDescriptor(
 			id.NewTable("D"),
 			[]string{},
+			[]string{},
 			id.NewIngressRelationList([]id.IngressRelation{
 				adRelationString("C->O", false, true),
 				adRelationString("O->D", false, true),
@@ -278,6 +376,7 @@ func newSimpleStep(index uint, tableName string, relation string, lookupParent b
 		id.NewIngressDescriptor(
 			id.NewTable("O"),
 			[]string{},
+			[]string{},
 			id.NewIngressRelationList([]id.IngressRelation{
 				adRelationString("C->O", false, true),
 				adRelationString("O->D", false, true),
@@ -293,6 +392,7 @@ func newSimpleStep(index uint, tableName string, relation string, lookupParent b
 		id.NewIngressDescriptor(
 			id.NewTable("C"),
 			[]string{},
+			[]string{},
 			id.NewIngressRelationList([]id.IngressRelation{
 				adRelationString("C->O", false, true),
 				adRelationString("O->D", false, true),
@@ -348,6 +448,7 @@ func newSimpleStep(index uint, tableName string, relation string, lookupParent b
 		id.NewIngressDescriptor(
 			id.NewTable("O"),
 			[]string{},
+			[]string{},
 			id.NewIngressRelationList([]id.IngressRelation{
 				adRelationString("C->O", true, false),
 			}),
@@ -397,53 +498,69 @@ func newSimpleStep(index uint, tableName string, relation string, lookupParent b
 }
 
 func TestUpdateStartTable(t *testing.T) {
-	storage := &MemoryStorage{id: id.NewIngressDescriptor(id.NewTable("old"), []string{}, id.NewIngressRelationList([]id.IngressRelation{
+	storage := &MemoryStorage{id: id.NewIngressDescriptor(id.NewTable("old"), []string{}, []string{}, id.NewIngressRelationList([]id.IngressRelation{
 		adRelationString("old->new", false, true),
 	}))}
 
 	err := id.SetStartTable(id.NewTable("new"), storage)
 
 	assert.Nil(t, err)
-	assert.Equal(t, id.NewIngressDescriptor(id.NewTable("new"), []string{}, id.NewIngressRelationList([]id.IngressRelation{
+	assert.Equal(t, id.NewIngressDescriptor(id.NewTable("new"), []string{}, []string{}, id.NewIngressRelationList([]id.IngressRelation{
 		adRelationString("old->new", false, true),
 	})), storage.id)
 }
 
 func TestUpdateStartTableCheckTable(t *testing.T) {
-	storage := &MemoryStorage{id: id.NewIngressDescriptor(id.NewTable("old"), []string{}, id.NewIngressRelationList([]id.IngressRelation{}))}
+	storage := &MemoryStorage{id: id.NewIngressDescriptor(id.NewTable("old"), []string{}, []string{}, id.NewIngressRelationList([]id.IngressRelation{}))}
 
 	err := id.SetStartTable(id.NewTable("new"), storage)
 
 	assert.EqualError(t, err, "Table new doesn't exist")
-	assert.Equal(t, id.NewIngressDescriptor(id.NewTable("old"), []string{}, id.NewIngressRelationList([]id.IngressRelation{})), storage.id)
+	assert.Equal(t, id.NewIngressDescriptor(id.NewTable("old"), []string{}, []string{}, id.NewIngressRelationList([]id.IngressRelation{})), storage.id)
 }
 
 func TestUpdateParentLookup(t *testing.T) {
-	storage := &MemoryStorage{id: id.NewIngressDescriptor(id.NewTable("A"), []string{}, id.NewIngressRelationList([]id.IngressRelation{
+	storage := &MemoryStorage{id: id.NewIngressDescriptor(id.NewTable("A"), []string{}, []string{}, id.NewIngressRelationList([]id.IngressRelation{
 		adRelationString("A->B", false, true),
 	}))}
 
 	err := id.SetParentLookup("A_B", true, storage)
 
 	assert.Nil(t, err)
-	assert.Equal(t, id.NewIngressDescriptor(id.NewTable("A"), []string{}, id.NewIngressRelationList([]id.IngressRelation{
+	assert.Equal(t, id.NewIngressDescriptor(id.NewTable("A"), []string{}, []string{}, id.NewIngressRelationList([]id.IngressRelation{
 		adRelationString("A->B", true, true),
 	})), storage.id)
 }
 
 func TestUpdateChildLookup(t *testing.T) {
-	storage := &MemoryStorage{id: id.NewIngressDescriptor(id.NewTable("A"), []string{}, id.NewIngressRelationList([]id.IngressRelation{
+	storage := &MemoryStorage{id: id.NewIngressDescriptor(id.NewTable("A"), []string{}, []string{}, id.NewIngressRelationList([]id.IngressRelation{
 		adRelationString("A->B", false, true),
 	}))}
 
 	err := id.SetChildLookup("A_B", false, storage)
 
 	assert.Nil(t, err)
-	assert.Equal(t, id.NewIngressDescriptor(id.NewTable("A"), []string{}, id.NewIngressRelationList([]id.IngressRelation{
+	assert.Equal(t, id.NewIngressDescriptor(id.NewTable("A"), []string{}, []string{}, id.NewIngressRelationList([]id.IngressRelation{
 		adRelationString("A->B", false, false),
 	})), storage.id)
 }
 
+func TestUpdateChildOrderAndLimit(t *testing.T) {
+	storage := &MemoryStorage{id: id.NewIngressDescriptor(id.NewTable("A"), []string{}, []string{}, id.NewIngressRelationList([]id.IngressRelation{
+		adRelationString("A->B", false, true),
+	}))}
+
+	err := id.SetChildOrder("A_B", []string{"created DESC"}, storage)
+	assert.Nil(t, err)
+
+	err = id.SetChildLimit("A_B", 10, storage)
+	assert.Nil(t, err)
+
+	assert.Equal(t, id.NewIngressDescriptor(id.NewTable("A"), []string{}, []string{}, id.NewIngressRelationList([]id.IngressRelation{
+		id.NewIngressRelation(relationString("A->B"), false, true, "", "", []string{}, []string{}, []string{}, []string{"created DESC"}, 10),
+	})), storage.id)
+}
+
 func TestGetSteps(t *testing.T) {
 	for i, tt := range adShowTests {
 		t.Run(fmt.Sprintf("test get step %d", i), func(t *testing.T) {
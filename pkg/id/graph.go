@@ -158,13 +158,13 @@ func (g graph) childrenOf(t Table) []Table {
 	return result
 }
 
-/* func (g graph) parentsOf(t Table) []Table {
+func (g graph) parentsOf(t Table) []Table {
 	result := []Table{}
 	for name := range g.parents[t.Name()] {
 		result = append(result, g.tabmap[name])
 	}
 	return result
-} */
+}
 
 func (g graph) relationsFrom(t Table) []Relation {
 	result := []Relation{}
@@ -27,10 +27,42 @@ func (g graph) findConnectedTables(start string) (TableList, *Error) {
 	return NewTableList(tables), nil
 }
 
-func (g graph) getConnectedGraph(start string) (graph, *Error) {
-	tables, err := g.findConnectedTables(start)
-	if err != nil {
+// getConnectedGraph returns the sub graph of the tables reachable from start following the relations in the
+// direction, up to maxDepth relations away from start (no limit if maxDepth is 0).
+func (g graph) getConnectedGraph(start string, direction Direction, maxDepth uint) (graph, *Error) {
+	tables := []Table{}
+	if err := g.visitDepth(start, direction, maxDepth, func(t Table, depth uint) {
+		tables = append(tables, t)
+	}); err != nil {
 		return graph{}, err
 	}
-	return g.subGraph(tables), nil
+	return g.subGraph(NewTableList(tables)), nil
+}
+
+// parentLookups returns the names of the relations to follow from child to parent so that every parent of a pulled
+// table is pulled too, skipping relations already followed from parent to child.
+func (g graph) parentLookups(pulled []Table, lookUpChild set) set {
+	result := newSet()
+	seen := newSet()
+	for _, t := range pulled {
+		seen.add(t.Name())
+	}
+
+	for len(pulled) > 0 {
+		t := pulled[0]
+		pulled = pulled[1:]
+		for i := uint(0); i < g.relations.Len(); i++ {
+			rel := g.relations.Relation(i)
+			if rel.Child().Name() != t.Name() || lookUpChild.contains(rel.Name()) {
+				continue
+			}
+			result.add(rel.Name())
+			if !seen.contains(rel.Parent().Name()) {
+				seen.add(rel.Parent().Name())
+				pulled = append(pulled, rel.Parent())
+			}
+		}
+	}
+
+	return result
 }
@@ -43,6 +43,51 @@ func (g graph) visitChildren(start string, visit func(Table)) *Error {
 	return t.followChilren(startTable)
 }
 
+// visit each table starting from the table named start in breadth-first order, following the relations in the
+// direction up to maxDepth relations away from start (no limit if maxDepth is 0).
+func (g graph) visitDepth(start string, direction Direction, maxDepth uint, visit func(t Table, depth uint)) *Error {
+	startTable, ok := g.tabmap[start]
+	if !ok {
+		return &Error{Description: "no table named " + start}
+	}
+
+	visited := newSet()
+	visited.add(startTable.Name())
+	current := []Table{startTable}
+
+	for depth := uint(0); len(current) > 0; depth++ {
+		next := []Table{}
+		for _, from := range current {
+			visit(from, depth)
+
+			if maxDepth > 0 && depth >= maxDepth {
+				continue
+			}
+
+			for _, to := range g.nextOf(from, direction) {
+				if !visited.contains(to.Name()) {
+					visited.add(to.Name())
+					next = append(next, to)
+				}
+			}
+		}
+		current = next
+	}
+
+	return nil
+}
+
+func (g graph) nextOf(t Table, direction Direction) []Table {
+	switch direction {
+	case DirectionChildren:
+		return g.childrenOf(t)
+	case DirectionParents:
+		return g.parentsOf(t)
+	default:
+		return g.neighboursOf(t)
+	}
+}
+
 // visit each table starting from the table named start following active relations only.
 /* func (g graph) visitActive(start string, visit func(r IngressRelation, comingFrom, goingTo Table, fromComponent, toComponent TableList, fromIndex, toIndex int, crossingBorder bool, fromStep, thisStep uint) bool) *Error {
 	t := &reltraversal{g, g.condense(), visit, map[int]set{}, 0}
@@ -53,9 +53,12 @@ type IngressRelation interface {
 	LookUpChild() bool
 	WhereChild() string
 	SelectChild() []string
+	OrderChild() []string
+	LimitChild() uint
 	LookUpParent() bool
 	WhereParent() string
 	SelectParent() []string
+	OrderParent() []string
 }
 
 // IngressRelationList involved in an puller plan.
@@ -70,6 +73,7 @@ type IngressRelationList interface {
 type IngressDescriptor interface {
 	StartTable() Table
 	Select() []string
+	OrderBy() []string
 	Relations() IngressRelationList
 	String() string
 }
@@ -106,6 +110,7 @@ type PullerPlan interface {
 	Tables() TableList
 	String() string
 	Select() []string
+	OrderBy() []string
 }
 
 // Error is the error type returned by the domain
@@ -116,3 +121,51 @@ type Error struct {
 func (e *Error) Error() string {
 	return e.Description
 }
+
+// Direction of the relations followed from the start table to create an ingress descriptor.
+type Direction byte
+
+const (
+	// DirectionBoth follows the relations to parents and children tables.
+	DirectionBoth Direction = iota
+	// DirectionChildren follows the relations to children tables only.
+	DirectionChildren
+	// DirectionParents follows the relations to parents tables only.
+	DirectionParents
+)
+
+// CreateOptions restricts the relations of a new ingress descriptor.
+type CreateOptions struct {
+	MaxDepth      uint      // maximum number of relations between the start table and a table, no limit if 0
+	Direction     Direction // direction of the relations followed from the start table
+	Filter        Filter    // tables not selected by the filter are excluded, if not nil
+	LookUpParents bool      // look up the parents of the pulled tables
+}
+
+// Change of a value between two ingress descriptors, Before is empty for an added value and After is empty for a
+// removed value.
+type Change struct {
+	Path   string
+	Before string
+	After  string
+}
+
+// StepChange is a step of the puller plan of only one of the two compared ingress descriptors.
+type StepChange struct {
+	Added bool
+	Step  string
+}
+
+// Differences lists the changes between two ingress descriptors and between their puller plans.
+type Differences struct {
+	Changes []Change
+	Steps   []StepChange
+}
+
+// Conflict of a value changed differently in both ingress descriptors of a merge, an empty value is a missing value.
+type Conflict struct {
+	Path   string
+	Base   string
+	Ours   string
+	Theirs string
+}
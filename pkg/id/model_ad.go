@@ -30,6 +30,9 @@ type idrelation struct {
 	whereChild   string
 	selectParent []string
 	selectChild  []string
+	orderParent  []string
+	orderChild   []string
+	limitChild   uint
 }
 
 // NewIngressRelation initialize a new IngressRelation object
@@ -41,6 +44,9 @@ func NewIngressRelation(
 	whereChild string,
 	selectParent []string,
 	selectChild []string,
+	orderParent []string,
+	orderChild []string,
+	limitChild uint,
 ) IngressRelation {
 	return idrelation{
 		Relation:     rel,
@@ -50,6 +56,9 @@ func NewIngressRelation(
 		whereChild:   whereChild,
 		selectParent: selectParent,
 		selectChild:  selectChild,
+		orderParent:  orderParent,
+		orderChild:   orderChild,
+		limitChild:   limitChild,
 	}
 }
 
@@ -59,9 +68,12 @@ func (r idrelation) Child() Table           { return r.Relation.Child() }
 func (r idrelation) LookUpParent() bool     { return r.lookUpParent }
 func (r idrelation) WhereParent() string    { return r.whereParent }
 func (r idrelation) SelectParent() []string { return r.selectParent }
+func (r idrelation) OrderParent() []string  { return r.orderParent }
 func (r idrelation) LookUpChild() bool      { return r.lookUpChild }
 func (r idrelation) WhereChild() string     { return r.whereChild }
 func (r idrelation) SelectChild() []string  { return r.selectChild }
+func (r idrelation) OrderChild() []string   { return r.orderChild }
+func (r idrelation) LimitChild() uint       { return r.limitChild }
 func (r idrelation) String() string {
 	switch {
 	case r.LookUpChild() && r.LookUpParent():
@@ -109,18 +121,20 @@ func (l idrelationList) String() string {
 }
 
 // NewIngressDescriptor initialize a new IngressDescriptor object
-func NewIngressDescriptor(start Table, selectColumns []string, relations IngressRelationList) IngressDescriptor {
-	return id{startTable: table{name: start.Name()}, selectColumns: selectColumns, relations: relations}
+func NewIngressDescriptor(start Table, selectColumns []string, orderBy []string, relations IngressRelationList) IngressDescriptor {
+	return id{startTable: table{name: start.Name()}, selectColumns: selectColumns, orderBy: orderBy, relations: relations}
 }
 
 type id struct {
 	startTable    table
 	selectColumns []string
+	orderBy       []string
 	relations     IngressRelationList
 }
 
 func (id id) StartTable() Table              { return id.startTable }
 func (id id) Select() []string               { return id.selectColumns }
+func (id id) OrderBy() []string              { return id.orderBy }
 func (id id) Relations() IngressRelationList { return id.relations }
 func (id id) String() string {
 	return fmt.Sprintf("%v [%v] (%v)", id.startTable, id.selectColumns, id.relations)
@@ -66,11 +66,12 @@ type pullerPlan struct {
 	relations   IngressRelationList
 	tables      TableList
 	startSelect []string
+	startOrder  []string
 }
 
 // NewPullerPlan initialize a new PullerPlan object
-func NewPullerPlan(steps []Step, relations IngressRelationList, tables TableList, startSelect []string) PullerPlan {
-	return pullerPlan{uint(len(steps)), steps, relations, tables, startSelect}
+func NewPullerPlan(steps []Step, relations IngressRelationList, tables TableList, startSelect []string, startOrder []string) PullerPlan {
+	return pullerPlan{uint(len(steps)), steps, relations, tables, startSelect, startOrder}
 }
 
 func (l pullerPlan) Len() uint                      { return l.len }
@@ -78,6 +79,7 @@ func (l pullerPlan) Step(idx uint) Step             { return l.slice[idx] }
 func (l pullerPlan) Relations() IngressRelationList { return l.relations }
 func (l pullerPlan) Tables() TableList              { return l.tables }
 func (l pullerPlan) Select() []string               { return l.startSelect }
+func (l pullerPlan) OrderBy() []string              { return l.startOrder }
 func (l pullerPlan) String() string {
 	switch l.len {
 	case 0:
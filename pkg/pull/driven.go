@@ -22,6 +22,12 @@ type RowExporter interface {
 	Export(ExportedRow) error
 }
 
+// FileStore writes the content of columns exported with the file format.
+type FileStore interface {
+	// Store writes the content and return the path to export in place of the value
+	Store(content []byte) (string, error)
+}
+
 // DataSourceFactory exposes methods to create new datasources.
 type DataSourceFactory interface {
 	New(url string, schema string) DataSource
@@ -37,6 +43,18 @@ type DataSource interface {
 	SafeUrl() string
 }
 
+// QueryExplainer is implemented by datasources able to describe a query without executing it.
+type QueryExplainer interface {
+	// Explain return the parameterized query that would be executed to read source with filter
+	Explain(source Table, filter Filter) string
+}
+
+// RowCounter is implemented by datasources able to count rows without reading them.
+type RowCounter interface {
+	// Count return the number of rows of source matching filter, the limit of the filter is ignored
+	Count(source Table, filter Filter) (int, error)
+}
+
 // RowReader over DataSource.
 type RowReader interface {
 	Next() bool
@@ -44,6 +62,17 @@ type RowReader interface {
 	Error() error
 }
 
+// Observer is notified of the pull progress, methods can be called concurrently by parallel workers.
+type Observer interface {
+	// Started is called once before reading start rows, total is -1 if the number of start rows is unknown
+	Started(total int)
+	// Fetched is called for each row read from a table
+	Fetched(table TableName)
+	// Exported is called for each start row exported with its related rows
+	Exported()
+	Close()
+}
+
 // TraceListener receives diagnostic trace.
 type TraceListener interface {
 	TraceStep(Step) TraceListener
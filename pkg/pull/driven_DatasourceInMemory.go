@@ -18,8 +18,11 @@
 package pull
 
 import (
+	"cmp"
 	"fmt"
 	"math"
+	"sort"
+	"strings"
 
 	"github.com/rs/zerolog/log"
 )
@@ -67,6 +70,11 @@ func (ds DataSourceInMemory) RowReader(source Table, filter Filter) (RowReader,
 	if !ok {
 		return nil, nil
 	}
+
+	if len(filter.OrderBy) > 0 {
+		allRows = sortRows(allRows, filter.OrderBy)
+	}
+
 LOOK_FOR_MATCHING_ROWS:
 	for _, row := range allRows {
 		for key, expected := range filter.Values {
@@ -94,6 +102,59 @@ func (ds DataSourceInMemory) RowReader(source Table, filter Filter) (RowReader,
 	return &RowReaderInMemory{result}, nil
 }
 
+// Count returns the number of rows matching the filter, the limit is ignored.
+func (ds DataSourceInMemory) Count(source Table, filter Filter) (int, error) {
+	rows, err := ds.Read(source, Filter{Values: filter.Values, Where: filter.Where, Distinct: filter.Distinct})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(rows), nil
+}
+
+// sortRows returns a sorted copy of rows, each order item is a column name optionally followed by ASC or DESC.
+func sortRows(rows RowSet, orderBy []string) RowSet {
+	sorted := make(RowSet, len(rows))
+	copy(sorted, rows)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		for _, order := range orderBy {
+			fields := strings.Fields(order)
+			if len(fields) == 0 {
+				continue
+			}
+
+			result := compareValues(sorted[i][fields[0]], sorted[j][fields[0]])
+			if len(fields) > 1 && strings.EqualFold(fields[1], "DESC") {
+				result = -result
+			}
+
+			if result != 0 {
+				return result < 0
+			}
+		}
+
+		return false
+	})
+
+	return sorted
+}
+
+func compareValues(a, b interface{}) int {
+	switch va := a.(type) {
+	case int:
+		if vb, ok := b.(int); ok {
+			return cmp.Compare(va, vb)
+		}
+	case float64:
+		if vb, ok := b.(float64); ok {
+			return cmp.Compare(va, vb)
+		}
+	}
+
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
 type RowReaderInMemory struct {
 	rows RowSet
 }
@@ -54,14 +54,19 @@ type puller struct {
 	datasource DataSource
 	exporter   RowExporter
 	diagnostic TraceListener
+	files      FileStore
+	observers  observers
+	seen       *seenSet
 }
 
-func NewPuller(plan Plan, datasource DataSource, exporter RowExporter, diagnostic TraceListener) Puller {
+func NewPuller(plan Plan, datasource DataSource, exporter RowExporter, diagnostic TraceListener, files FileStore, obs ...Observer) Puller {
 	return &puller{
 		graph:      plan.buildGraph(),
 		datasource: datasource,
 		exporter:   exporter,
 		diagnostic: diagnostic,
+		files:      files,
+		observers:  obs,
 	}
 }
 
@@ -76,9 +81,21 @@ func (p *puller) Pull(start Table, filter Filter, selectColumns []string, filter
 	}
 
 	defer p.datasource.Close() //nolint:errcheck
+	defer p.observers.close()
 
 	Reset()
 
+	filters := cohortFilters(filter, filterCohort)
+
+	if len(p.observers) > 0 {
+		p.observers.started(countStartRows(p.datasource, start, filters))
+	}
+
+	return p.pullStart(start, filters, excluded)
+}
+
+// cohortFilters combines the filter with each row of the filter cohort.
+func cohortFilters(filter Filter, filterCohort RowReader) []Filter {
 	filters := []Filter{}
 	if filterCohort != nil {
 		for filterCohort.Next() {
@@ -95,6 +112,7 @@ func (p *puller) Pull(start Table, filter Filter, selectColumns []string, filter
 				Values:   values,
 				Where:    filter.Where,
 				Distinct: filter.Distinct,
+				OrderBy:  filter.OrderBy,
 			})
 		}
 	} else {
@@ -103,9 +121,16 @@ func (p *puller) Pull(start Table, filter Filter, selectColumns []string, filter
 			Values:   filter.Values,
 			Where:    filter.Where,
 			Distinct: filter.Distinct,
+			OrderBy:  filter.OrderBy,
 		})
 	}
 
+	return filters
+}
+
+// pullStart reads rows of the start table for each filter, follows the relations and exports the result.
+// Rows already exported are skipped if the puller has a seen set.
+func (p *puller) pullStart(start Table, filters []Filter, excluded KeyStore) error {
 	for _, f := range filters {
 		IncFiltersCount()
 		reader, err := p.datasource.RowReader(start, f)
@@ -115,12 +140,21 @@ func (p *puller) Pull(start Table, filter Filter, selectColumns []string, filter
 
 		for reader.Next() {
 			IncLinesPerStepCount(string(start.Name))
-			row := start.export(reader.Value())
+			p.observers.fetched(start.Name)
+			row, err := p.exportRow(&start, reader.Value())
+			if err != nil {
+				return err
+			}
 
 			if excluded != nil && excluded.Has(extract(row, start.Keys)) {
 				continue
 			}
 
+			if p.seen != nil && !p.seen.add(start, row) {
+				log.Trace().Interface("table", start.Name).Msg("start row removed because it has been exported")
+				continue
+			}
+
 			if err := p.pull(start, row); err != nil {
 				return fmt.Errorf("%w", err)
 			}
@@ -128,6 +162,8 @@ func (p *puller) Pull(start Table, filter Filter, selectColumns []string, filter
 			if err := p.exporter.Export(row); err != nil {
 				return fmt.Errorf("%w", err)
 			}
+
+			p.observers.exported()
 		}
 
 		if reader.Error() != nil {
@@ -219,14 +255,25 @@ func (s *Step) follow(relation Relation, out ExportedRow, currentStep uint) erro
 
 	filter := createFilter(relation, out)
 
-	rows, err := s.p.datasource.Read(relation.Foreign.Table, Filter{Limit: 0, Values: filter, Where: relation.Where})
+	rows, err := s.p.datasource.Read(relation.Foreign.Table, Filter{Limit: relation.Limit, Values: filter, Where: relation.Where, OrderBy: relation.OrderBy})
 	IncFiltersCount()
 
 	if err != nil {
 		return fmt.Errorf("%w", err)
 	}
 
-	exportedRows := s.removeDuplicates(relation.Foreign.Table, rows...)
+	for range rows {
+		s.p.observers.fetched(relation.Foreign.Table.Name)
+	}
+
+	exportedRows, err := s.removeDuplicates(relation.Foreign.Table, rows...)
+	if err != nil {
+		return err
+	}
+
+	if s.p.seen != nil {
+		exportedRows = s.p.seen.filter(relation.Foreign.Table, exportedRows)
+	}
 
 	if relation.Cardinality == One {
 		switch {
@@ -249,17 +296,21 @@ func (s *Step) follow(relation Relation, out ExportedRow, currentStep uint) erro
 	return nil
 }
 
-func (s *Step) removeDuplicates(table Table, rows ...Row) []ExportedRow {
+func (s *Step) removeDuplicates(table Table, rows ...Row) ([]ExportedRow, error) {
 	result := []ExportedRow{}
 
 	// this table is not involved in a local tip (only foreign)
 	// then it is not needed to cache seen pks
 	if !s.p.graph.Cached[table.Name] {
 		for _, row := range rows {
-			result = append(result, table.export(row))
+			exported, err := s.p.exportRow(&table, row)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, exported)
 		}
 
-		return result
+		return result, nil
 	}
 
 loop:
@@ -279,12 +330,51 @@ func (s *Step) removeDuplicates(table Table, rows ...Row) []ExportedRow {
 				continue loop
 			}
 		}
-		result = append(result, table.export(row1))
+		exported, err := s.p.exportRow(&table, row1)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, exported)
 	}
 
 	s.addToCache(table, rows...)
 
-	return result
+	return result, nil
+}
+
+// exportRow exports the row and writes the values of columns with the file format in the file store.
+func (p *puller) exportRow(table *Table, row Row) (ExportedRow, error) {
+	result := table.export(row)
+
+	for _, col := range table.Columns {
+		if col.Export != "file" || row[col.Name] == nil {
+			continue
+		}
+
+		var content []byte
+
+		switch value := row[col.Name].(type) {
+		case []byte:
+			content = value
+		case string:
+			content = []byte(value)
+		default:
+			return ExportedRow{}, fmt.Errorf("%w: %T in column %s", ErrUnsupportedFileValue, value, col.Name)
+		}
+
+		if p.files == nil {
+			return ExportedRow{}, fmt.Errorf("%w: column %s", ErrNoFileStore, col.Name)
+		}
+
+		path, err := p.files.Store(content)
+		if err != nil {
+			return ExportedRow{}, fmt.Errorf("%w", err)
+		}
+
+		result.Set(col.Name, path)
+	}
+
+	return result, nil
 }
 
 func (s *Step) addToCache(table Table, rows ...Row) {
@@ -0,0 +1,133 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package pull
+
+import (
+	"sort"
+)
+
+// ExplainedQuery describes a query that the puller would execute.
+type ExplainedQuery struct {
+	Step        uint     `json:"step"`
+	Relation    string   `json:"relation,omitempty"`
+	Cardinality string   `json:"cardinality,omitempty"`
+	From        string   `json:"from,omitempty"`
+	Table       string   `json:"table"`
+	Columns     []string `json:"columns"`
+	Where       string   `json:"where,omitempty"`
+	OrderBy     []string `json:"orderBy,omitempty"`
+	Limit       uint     `json:"limit,omitempty"`
+	Keys        []string `json:"keys,omitempty"`
+	BoundFrom   []string `json:"boundFrom,omitempty"`
+	SQL         string   `json:"sql"`
+}
+
+// Explain walks the plan and describes each query the puller would execute, nothing is read from the datasource.
+func Explain(plan Plan, start Table, filter Filter, selectColumns []string, explainer QueryExplainer) []ExplainedQuery {
+	graph := plan.buildGraph()
+
+	start.selectColumns(selectColumns...)
+	start = graph.addMissingColumns(start)
+
+	startKeys := make([]string, 0, len(filter.Values))
+	for key := range filter.Values {
+		startKeys = append(startKeys, key)
+	}
+	sort.Strings(startKeys)
+
+	result := []ExplainedQuery{{
+		Step:    plan.Components[start.Name] + 1,
+		Table:   string(start.Name),
+		Columns: columnNames(start),
+		Where:   filter.Where,
+		OrderBy: filter.OrderBy,
+		Limit:   filter.Limit,
+		Keys:    startKeys,
+		SQL:     explainer.Explain(start, filter),
+	}}
+
+	// graph relations are grouped by local table, in the same order than plan relations
+	seen := map[TableName]int{}
+	for _, planRelation := range plan.Relations {
+		local := planRelation.Local.Table.Name
+		relation := graph.Relations[local][seen[local]]
+		seen[local]++
+
+		values := Row{}
+		for _, key := range relation.Foreign.Keys {
+			values[key] = nil
+		}
+
+		keys, boundFrom := sortedKeys(relation)
+
+		cardinality := "one"
+		if relation.Cardinality == Many {
+			cardinality = "many"
+		}
+
+		result = append(result, ExplainedQuery{
+			Step:        plan.Components[relation.Foreign.Table.Name] + 1,
+			Relation:    string(relation.Name),
+			Cardinality: cardinality,
+			From:        string(local),
+			Table:       string(relation.Foreign.Table.Name),
+			Columns:     columnNames(relation.Foreign.Table),
+			Where:       relation.Where,
+			OrderBy:     relation.OrderBy,
+			Limit:       relation.Limit,
+			Keys:        keys,
+			BoundFrom:   boundFrom,
+			SQL:         explainer.Explain(relation.Foreign.Table, Filter{Limit: relation.Limit, Values: values, Where: relation.Where, OrderBy: relation.OrderBy}),
+		})
+	}
+
+	sort.SliceStable(result, func(i, j int) bool { return result[i].Step < result[j].Step })
+
+	return result
+}
+
+// sortedKeys returns the foreign keys sorted by name (the order of placeholders) and the matching local keys.
+func sortedKeys(relation Relation) ([]string, []string) {
+	idx := make([]int, len(relation.Foreign.Keys))
+	for i := range idx {
+		idx[i] = i
+	}
+
+	sort.Slice(idx, func(i, j int) bool { return relation.Foreign.Keys[idx[i]] < relation.Foreign.Keys[idx[j]] })
+
+	keys := make([]string, 0, len(idx))
+	boundFrom := make([]string, 0, len(idx))
+
+	for _, i := range idx {
+		keys = append(keys, relation.Foreign.Keys[i])
+		if i < len(relation.Local.Keys) {
+			boundFrom = append(boundFrom, string(relation.Local.Table.Name)+"."+relation.Local.Keys[i])
+		}
+	}
+
+	return keys, boundFrom
+}
+
+func columnNames(table Table) []string {
+	result := make([]string, 0, len(table.Columns))
+	for _, column := range table.Columns {
+		result = append(result, column.Name)
+	}
+
+	return result
+}
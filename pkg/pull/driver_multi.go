@@ -0,0 +1,163 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package pull
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+// StartPoint is one entry of a pull with several start tables.
+type StartPoint struct {
+	Plan   Plan
+	Table  Table
+	Filter Filter
+	Select []string
+}
+
+// MultiPuller pulls several start points in a single session.
+type MultiPuller interface {
+	Pull(starts []StartPoint) error
+}
+
+type multiPuller struct {
+	datasource DataSource
+	exporter   RowExporter
+	diagnostic TraceListener
+	files      FileStore
+	observers  observers
+}
+
+// NewMultiPuller creates a puller that shares the datasource and the set of exported rows between start points.
+func NewMultiPuller(datasource DataSource, exporter RowExporter, diagnostic TraceListener, files FileStore, obs ...Observer) MultiPuller {
+	return &multiPuller{
+		datasource: datasource,
+		exporter:   exporter,
+		diagnostic: diagnostic,
+		files:      files,
+		observers:  obs,
+	}
+}
+
+func (mp *multiPuller) Pull(starts []StartPoint) error {
+	log.Info().
+		Str("url", mp.datasource.SafeUrl()).
+		Msg("Open database")
+	if err := mp.datasource.Open(); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	defer mp.datasource.Close() //nolint:errcheck
+	defer mp.observers.close()
+
+	Reset()
+
+	if len(mp.observers) > 0 {
+		mp.observers.started(mp.countStartRows(starts))
+	}
+
+	seen := newSeenSet()
+
+	for idx, sp := range starts {
+		p := &puller{
+			graph:      sp.Plan.buildGraph(),
+			datasource: mp.datasource,
+			exporter:   mp.exporter,
+			diagnostic: mp.diagnostic,
+			files:      mp.files,
+			observers:  mp.observers,
+			seen:       seen,
+		}
+
+		start := sp.Table
+		start.selectColumns(sp.Select...)
+		start = p.graph.addMissingColumns(start)
+
+		log.Info().Int("index", idx).Interface("table", start.Name).Msg("pull start point")
+
+		if err := p.pullStart(start, []Filter{sp.Filter}, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// countStartRows returns the number of start rows of all start points, or -1 if one of them is unknown.
+func (mp *multiPuller) countStartRows(starts []StartPoint) int {
+	total := 0
+
+	for _, sp := range starts {
+		count := countStartRows(mp.datasource, sp.Table, []Filter{sp.Filter})
+		if count < 0 {
+			return -1
+		}
+
+		total += count
+	}
+
+	return total
+}
+
+// seenSet records the keys of rows already exported, for each table.
+type seenSet map[TableName]map[string]struct{}
+
+func newSeenSet() *seenSet {
+	return &seenSet{}
+}
+
+// add returns false if the row has already been added.
+func (s *seenSet) add(table Table, row ExportedRow) bool {
+	if len(table.Keys) == 0 {
+		return true
+	}
+
+	sb := strings.Builder{}
+	for _, key := range table.Keys {
+		fmt.Fprintf(&sb, "%T:%v\x00", row.GetOrNil(key), row.GetOrNil(key))
+	}
+
+	keys, ok := (*s)[table.Name]
+	if !ok {
+		keys = map[string]struct{}{}
+		(*s)[table.Name] = keys
+	}
+
+	if _, exists := keys[sb.String()]; exists {
+		return false
+	}
+
+	keys[sb.String()] = struct{}{}
+
+	return true
+}
+
+// filter returns the rows not already added, and adds them.
+func (s *seenSet) filter(table Table, rows []ExportedRow) []ExportedRow {
+	result := make([]ExportedRow, 0, len(rows))
+
+	for _, row := range rows {
+		if s.add(table, row) {
+			result = append(result, row)
+		}
+	}
+
+	return result
+}
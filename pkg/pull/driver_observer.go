@@ -0,0 +1,81 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package pull
+
+import (
+	"math"
+
+	"github.com/rs/zerolog/log"
+)
+
+// observers notifies each observer of the pull progress.
+type observers []Observer
+
+func (o observers) started(total int) {
+	for _, observer := range o {
+		if observer != nil {
+			observer.Started(total)
+		}
+	}
+}
+
+func (o observers) fetched(table TableName) {
+	for _, observer := range o {
+		if observer != nil {
+			observer.Fetched(table)
+		}
+	}
+}
+
+func (o observers) exported() {
+	for _, observer := range o {
+		if observer != nil {
+			observer.Exported()
+		}
+	}
+}
+
+func (o observers) close() {
+	for _, observer := range o {
+		if observer != nil {
+			observer.Close()
+		}
+	}
+}
+
+// countStartRows returns the number of start rows to pull, or -1 if it is unknown.
+// Rows are counted only with a single filter to avoid doubling the queries of a filter cohort.
+func countStartRows(datasource DataSource, start Table, filters []Filter) int {
+	counter, ok := datasource.(RowCounter)
+	if !ok || len(filters) != 1 {
+		return -1
+	}
+
+	count, err := counter.Count(start, filters[0])
+	if err != nil {
+		log.Warn().Err(err).Interface("table", start.Name).Msg("unable to count start rows")
+
+		return -1
+	}
+
+	if limit := filters[0].Limit; limit > 0 && limit <= math.MaxInt32 && count > int(limit) { //nolint:gosec
+		count = int(limit) //nolint:gosec
+	}
+
+	return count
+}
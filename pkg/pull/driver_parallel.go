@@ -38,12 +38,14 @@ type pullerParallel struct {
 	excluded  KeyStore
 }
 
-func NewPullerParallel(plan Plan, datasource DataSource, exporter RowExporter, diagnostic TraceListener, nbworkers uint) Puller { //nolint:lll
+func NewPullerParallel(plan Plan, datasource DataSource, exporter RowExporter, diagnostic TraceListener, files FileStore, nbworkers uint, obs ...Observer) Puller { //nolint:lll
 	puller := &puller{
 		graph:      plan.buildGraph(),
 		datasource: datasource,
 		exporter:   exporter,
 		diagnostic: diagnostic,
+		files:      files,
+		observers:  obs,
 	}
 
 	if nbworkers > 1 {
@@ -70,32 +72,12 @@ func (p *pullerParallel) Pull(start Table, filter Filter, selectColumns []string
 	}
 
 	defer p.datasource.Close() //nolint:errcheck
+	defer p.observers.close()
 
-	filters := []Filter{}
-	if filterCohort != nil {
-		for filterCohort.Next() {
-			fc := filterCohort.Value()
-			values := Row{}
-			for key, val := range fc {
-				values[key] = val
-			}
-			for key, val := range filter.Values {
-				values[key] = val
-			}
-			filters = append(filters, Filter{
-				Limit:    filter.Limit,
-				Values:   values,
-				Where:    filter.Where,
-				Distinct: filter.Distinct,
-			})
-		}
-	} else {
-		filters = append(filters, Filter{
-			Limit:    filter.Limit,
-			Values:   filter.Values,
-			Where:    filter.Where,
-			Distinct: filter.Distinct,
-		})
+	filters := cohortFilters(filter, filterCohort)
+
+	if len(p.observers) > 0 {
+		p.observers.started(countStartRows(p.datasource, start, filters))
 	}
 
 	p.inChan = make(chan Row)
@@ -124,6 +106,7 @@ func (p *pullerParallel) Pull(start Table, filter Filter, selectColumns []string
 
 		for reader.Next() {
 			IncLinesPerStepCount(string(start.Name))
+			p.observers.fetched(start.Name)
 			p.inChan <- reader.Value()
 		}
 
@@ -166,13 +149,18 @@ func (p *pullerParallel) worker(ctx context.Context, wg *sync.WaitGroup, id uint
 			}
 			log.Debug().Msg("received row")
 
-			out := start.export(row)
+			out, err := p.exportRow(&start, row)
+			if err != nil {
+				p.errChan <- err
+
+				continue
+			}
 
 			if p.excluded != nil && p.excluded.Has(extract(out, start.Keys)) {
 				continue
 			}
 
-			err := p.pull(start, out)
+			err = p.pull(start, out)
 			if err != nil {
 				p.errChan <- err
 			} else {
@@ -214,6 +202,8 @@ func (p *pullerParallel) collect(done chan<- struct{}) {
 
 			if err := p.exporter.Export(result); err != nil {
 				p.errors = append(p.errors, err)
+			} else {
+				p.observers.exported()
 			}
 		}
 	}
@@ -70,7 +70,7 @@ func RunTest(t *testing.T, test *Test) {
 	// over.New(zerolog.New(os.Stderr))
 	collector := pull.NewRowExporterCollector()
 
-	puller := pull.NewPuller(test.Plan, pull.NewDataSourceInMemory(test.DataSet), collector, pull.NoTraceListener{})
+	puller := pull.NewPuller(test.Plan, pull.NewDataSourceInMemory(test.DataSet), collector, pull.NoTraceListener{}, nil)
 
 	for _, execution := range test.Executions {
 		collector.Reset()
@@ -90,7 +90,7 @@ func RunBench(b *testing.B, test *Test) {
 
 	collector := pull.NewRowExporterCollector()
 
-	puller := pull.NewPuller(test.Plan, pull.NewDataSourceInMemory(test.DataSet), collector, pull.NoTraceListener{})
+	puller := pull.NewPuller(test.Plan, pull.NewDataSourceInMemory(test.DataSet), collector, pull.NoTraceListener{}, nil)
 
 	for _, execution := range test.Executions {
 		collector.Reset()
@@ -168,12 +168,160 @@ func TestPull4(t *testing.T) {
 	LoadAndRunTest(t, "test4.yaml")
 }
 
+func TestOrderLimit(t *testing.T) {
+	t.Parallel()
+
+	LoadAndRunTest(t, "order_limit.yaml")
+}
+
 func TestBug1(t *testing.T) {
 	t.Parallel()
 
 	LoadAndRunTest(t, "bug1.yaml")
 }
 
+func LoadAndRunMultiTest(t *testing.T, filename string) {
+	t.Helper()
+
+	yamlFile, err := os.ReadFile("testdata/" + filename) //nolint:gosec
+	assert.NoError(t, err)
+
+	test := &struct {
+		DataSet pull.DataSet
+		Starts  []pull.StartPoint
+		Result  []string
+	}{}
+	assert.NoError(t, yaml.Unmarshal(yamlFile, test))
+
+	collector := pull.NewRowExporterCollector()
+	puller := pull.NewMultiPuller(pull.NewDataSourceInMemory(test.DataSet), collector, pull.NoTraceListener{}, nil)
+
+	assert.NoError(t, puller.Pull(test.Starts))
+	assert.Len(t, collector.Result, len(test.Result))
+
+	for i := 0; i < len(test.Result) && i < len(collector.Result); i++ {
+		assert.Equal(t, test.Result[i], collector.Result[i].String())
+	}
+}
+
+func TestMultiPuller(t *testing.T) {
+	t.Parallel()
+
+	LoadAndRunMultiTest(t, "multi.yaml")
+}
+
+func TestMultiPullerRelatedRows(t *testing.T) {
+	t.Parallel()
+
+	LoadAndRunMultiTest(t, "multi_related.yaml")
+}
+
+func TestMultiPullerKeyTypes(t *testing.T) {
+	t.Parallel()
+
+	LoadAndRunMultiTest(t, "multi_keytypes.yaml")
+}
+
+type mockObserver struct {
+	total    int
+	exported int
+	fetched  map[pull.TableName]int
+	closed   bool
+}
+
+func (o *mockObserver) Started(total int)            { o.total = total }
+func (o *mockObserver) Fetched(table pull.TableName) { o.fetched[table]++ }
+func (o *mockObserver) Exported()                    { o.exported++ }
+func (o *mockObserver) Close()                       { o.closed = true }
+
+func TestPullWithObservers(t *testing.T) {
+	t.Parallel()
+
+	test, err := LoadTest("order_limit.yaml")
+	assert.NoError(t, err)
+
+	obs := &mockObserver{fetched: map[pull.TableName]int{}}
+	collector := pull.NewRowExporterCollector()
+	puller := pull.NewPuller(test.Plan, pull.NewDataSourceInMemory(test.DataSet), collector, pull.NoTraceListener{}, nil, obs)
+
+	assert.NoError(t, puller.Pull(test.Executions[0].Start, test.Executions[0].Filter, nil, nil, nil))
+
+	assert.Equal(t, 2, obs.total)
+	assert.Equal(t, 2, obs.exported)
+	assert.Equal(t, map[pull.TableName]int{"customer": 2, "orders": 3}, obs.fetched)
+	assert.True(t, obs.closed)
+}
+
+type memoryFileStore map[string][]byte
+
+func (fs memoryFileStore) Store(content []byte) (string, error) {
+	path := fmt.Sprintf("files/%d", len(fs))
+	fs[path] = content
+
+	return path, nil
+}
+
+func TestPullWithFileFormat(t *testing.T) {
+	t.Parallel()
+
+	document := pull.Table{
+		Name: "document",
+		Keys: []string{"id"},
+		Columns: []pull.Column{
+			{Name: "id"},
+			{Name: "content", Export: "file"},
+		},
+	}
+	dataset := pull.DataSet{
+		"document": {
+			{"id": 1, "content": []byte("hello")},
+			{"id": 2, "content": nil},
+			{"id": 3, "content": "world"},
+		},
+	}
+
+	files := memoryFileStore{}
+	collector := pull.NewRowExporterCollector()
+	puller := pull.NewPuller(pull.Plan{}, pull.NewDataSourceInMemory(dataset), collector, pull.NoTraceListener{}, files)
+
+	assert.NoError(t, puller.Pull(document, pull.Filter{Values: pull.Row{}}, nil, nil, nil))
+	assert.Len(t, collector.Result, 3)
+	assert.Equal(t, `{"id":1,"content":"files/0"}`, collector.Result[0].String())
+	assert.Equal(t, `{"id":2,"content":null}`, collector.Result[1].String())
+	assert.Equal(t, `{"id":3,"content":"files/1"}`, collector.Result[2].String())
+	assert.Equal(t, []byte("hello"), files["files/0"])
+	assert.Equal(t, []byte("world"), files["files/1"])
+}
+
+type explainerFunc func(source pull.Table, filter pull.Filter) string
+
+func (f explainerFunc) Explain(source pull.Table, filter pull.Filter) string {
+	return f(source, filter)
+}
+
+func TestExplain(t *testing.T) {
+	t.Parallel()
+
+	test, err := LoadTest("order_limit.yaml")
+	assert.NoError(t, err)
+
+	explainer := explainerFunc(func(source pull.Table, filter pull.Filter) string {
+		return fmt.Sprintf("%s %d %v", source.Name, filter.Limit, filter.OrderBy)
+	})
+
+	queries := pull.Explain(test.Plan, test.Executions[0].Start, test.Executions[0].Filter, nil, explainer)
+
+	assert.Len(t, queries, 2)
+	assert.Equal(t, "customer", queries[0].Table)
+	assert.Equal(t, "customer 2 [name DESC]", queries[0].SQL)
+	assert.Equal(t, "orders", queries[1].Relation)
+	assert.Equal(t, "many", queries[1].Cardinality)
+	assert.Equal(t, []string{"customer_id"}, queries[1].Keys)
+	assert.Equal(t, []string{"customer.id"}, queries[1].BoundFrom)
+	assert.Equal(t, []string{"id", "customer_id", "ordered"}, queries[1].Columns)
+	assert.Equal(t, "orders 2 [ordered DESC]", queries[1].SQL)
+}
+
 func BenchmarkSimpleWithComponents(b *testing.B) {
 	test, _ := LoadTest("simple.yaml")
 
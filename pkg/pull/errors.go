@@ -19,4 +19,8 @@
 
 import "errors"
 
-var ErrMultipleRowInOneToOneRelation = errors.New("multiple rows for one to one relationship")
+var (
+	ErrMultipleRowInOneToOneRelation = errors.New("multiple rows for one to one relationship")
+	ErrNoFileStore                   = errors.New("no file store to export column with file format")
+	ErrUnsupportedFileValue          = errors.New("unsupported value type for file format")
+)
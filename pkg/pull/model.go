@@ -73,6 +73,8 @@ type Relation struct {
 	Foreign     RelationTip
 	Where       string
 	Select      []string
+	OrderBy     []string
+	Limit       uint
 }
 
 type RelationSet []Relation
@@ -95,10 +97,11 @@ type Row map[string]interface{
 type DataSet map[TableName]RowSet
 
 type Filter struct {
-	Limit    uint   `json:"limit"`
-	Values   Row    `json:"values"`
-	Where    string `json:"where"`
-	Distinct bool   `json:"distinct"`
+	Limit    uint     `json:"limit"`
+	Values   Row      `json:"values"`
+	Where    string   `json:"where"`
+	Distinct bool     `json:"distinct"`
+	OrderBy  []string `json:"orderBy"`
 }
 
 // ExportedRow is a row but with keys ordered and values in export format for jsonline.
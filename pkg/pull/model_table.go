@@ -32,7 +32,7 @@ func (t *Table) initTemplate() {
 			key := column.Name
 
 			switch column.Export {
-			case "string":
+			case "string", "file":
 				t.template.WithString(key)
 			case "numeric":
 				t.template.WithNumeric(key)
@@ -17,11 +17,25 @@
 
 package push
 
+import "io"
+
 // DataDestinationFactory exposes methods to create new datadestinations.
 type DataDestinationFactory interface {
 	New(url string, schema string) DataDestination
 }
 
+// ScriptDataDestinationFactory is a DataDestinationFactory able to write the push as a SQL script.
+type ScriptDataDestinationFactory interface {
+	// NewScript creates a datadestination writing statements to script, without connection to the database
+	NewScript(script io.Writer, schema string) (DataDestination, *Error)
+}
+
+// DiffDataDestinationFactory is a DataDestinationFactory able to compare pushed rows with the rows of the database.
+type DiffDataDestinationFactory interface {
+	// NewDiff creates a datadestination writing the change of each pushed row to report, nothing is written in the database
+	NewDiff(url string, schema string, report RowWriter) DataDestination
+}
+
 // DataDestination to write in the push process.
 type DataDestination interface {
 	Open(plan Plan, mode Mode, disableConstraints bool, whereClause string) *Error
@@ -32,16 +46,96 @@ type DataDestination interface {
 	SafeUrl() string
 }
 
+// BulkDataDestination is a DataDestination able to load rows in bulk.
+type BulkDataDestination interface {
+	DataDestination
+	// SetBulk activates the bulk load, rows are buffered by table and loaded at each commit
+	SetBulk(bulk bool)
+}
+
+// JournalDataDestination is a DataDestination able to record the prior state of modified rows, and to revert them.
+type JournalDataDestination interface {
+	DataDestination
+	// SetJournal records the prior state of modified rows in journal, entries are written at each commit
+	SetJournal(journal RowWriter)
+	// Undo replays the inverse of the changes recorded in journal, last change first
+	Undo(journal RowIterator) *Error
+}
+
+// ConflictDataDestination is a DataDestination able to resolve conflicts on primary keys with a policy.
+type ConflictDataDestination interface {
+	DataDestination
+	// SetConflictPolicy sets the policy applied in insert, truncate and upsert modes
+	SetConflictPolicy(policy ConflictPolicy)
+}
+
+// RecoverableDataDestination is a DataDestination able to cancel the changes since the last commit.
+type RecoverableDataDestination interface {
+	DataDestination
+	// Rollback cancels the changes since the last commit and starts a new transaction, on a new connection if reconnect is true
+	Rollback(reconnect bool) *Error
+}
+
 // RowWriter write row to destination table
 type RowWriter interface {
 	// Write row in external datasource. where is optional and can contains additional key=value to use in the where clause.
 	Write(row Row, where Row) *Error
 }
 
+// GeneratedKeyRowWriter is a RowWriter able to read back the key generated by the database on insert.
+type GeneratedKeyRowWriter interface {
+	RowWriter
+	// WriteReturningKey inserts row (without the generated column) and returns the value generated for the column key
+	WriteReturningKey(row Row, key string) (Value, *Error)
+}
+
+// Failure describes why an input line was rejected
+type Failure struct {
+	Line       uint
+	Table      string
+	Mode       Mode
+	Code       string
+	Constraint string
+	Message    string
+}
+
+// ErrorRowWriter is a RowWriter able to capture why a row was rejected.
+type ErrorRowWriter interface {
+	RowWriter
+	// WriteError captures the rejected input row with the description of its failure
+	WriteError(row Row, failure Failure) *Error
+}
+
+// EnvelopeRowWriter captures rejected rows wrapped in an envelope describing the failure.
+type EnvelopeRowWriter struct {
+	writer RowWriter
+}
+
+// NewEnvelopeRowWriter creates a new EnvelopeRowWriter writing envelopes to writer.
+func NewEnvelopeRowWriter(writer RowWriter) *EnvelopeRowWriter {
+	return &EnvelopeRowWriter{writer: writer}
+}
+
+func (erw *EnvelopeRowWriter) Write(row Row, where Row) *Error {
+	return erw.writer.Write(row, where)
+}
+
+func (erw *EnvelopeRowWriter) WriteError(row Row, failure Failure) *Error {
+	return erw.writer.Write(Row{
+		"line":       failure.Line,
+		"table":      failure.Table,
+		"mode":       failure.Mode.String(),
+		"code":       failure.Code,
+		"constraint": failure.Constraint,
+		"message":    failure.Message,
+		"row":        row,
+	}, nil)
+}
+
 type NoErrorCaptureRowWriter struct{}
 
 func (necrw NoErrorCaptureRowWriter) Write(row Row, where Row) *Error {
-	return &Error{"No error capture configured"}
+	return &Error{Description: "No error capture configured"}
 }
 
 // RowIterator iter over a collection of rows
@@ -62,6 +156,21 @@ type Translator interface {
 	Load(keys []Key, rows RowIterator) *Error
 }
 
+// KeyRecorder is a Translator recording the keys generated by the database on insert.
+type KeyRecorder interface {
+	Translator
+	// Generate declares key as generated by the database
+	Generate(key Key)
+	// IsGenerated returns true if the values of key are generated by the database
+	IsGenerated(key Key) bool
+	// Record the value generated by the database in place of the value read in input
+	Record(key Key, value Value, generated Value)
+	// Generated returns the value generated by the database in place of the value read in input
+	Generated(key Key, value Value) (Value, bool)
+	// GeneratedKeys returns the keys declared as generated with the mapping of input values to generated values
+	GeneratedKeys() map[Key]Cache
+}
+
 type Observer interface {
 	Pushed()
 	Close()
@@ -56,6 +56,8 @@ type memoryDataDestination struct {
 	committed bool
 	opened    bool
 	commits   int
+	mode      push.Mode
+	disabled  bool
 }
 
 func (mdd *memoryDataDestination) SafeUrl() string {
@@ -72,6 +74,8 @@ func (mdd *memoryDataDestination) OpenSQLLogger(string) error {
 
 func (mdd *memoryDataDestination) Open(pla push.Plan, mode push.Mode, disableConstraints bool, where string) *push.Error {
 	mdd.opened = true
+	mdd.mode = mode
+	mdd.disabled = disableConstraints
 	return nil
 }
 
@@ -95,3 +99,79 @@ func (rw *rowWriter) Write(row push.Row, where push.Row) *push.Error {
 	rw.rows = append(rw.rows, row)
 	return nil
 }
+
+func (rw *rowWriter) WriteReturningKey(row push.Row, key string) (push.Value, *push.Error) {
+	rw.rows = append(rw.rows, row)
+	return 1000 + len(rw.rows), nil
+}
+
+type keyRecorder struct {
+	mockTranslator
+	generated map[push.Key]push.Cache
+}
+
+func (kr *keyRecorder) Generate(key push.Key) {
+	kr.generated[key] = push.Cache{}
+}
+
+func (kr *keyRecorder) IsGenerated(key push.Key) bool {
+	_, ok := kr.generated[key]
+	return ok
+}
+
+func (kr *keyRecorder) Record(key push.Key, value push.Value, generated push.Value) {
+	kr.generated[key][value] = generated
+}
+
+func (kr *keyRecorder) Generated(key push.Key, value push.Value) (push.Value, bool) {
+	generated, ok := kr.generated[key][value]
+	return generated, ok
+}
+
+func (kr *keyRecorder) GeneratedKeys() map[push.Key]push.Cache {
+	return kr.generated
+}
+
+// recoverableDataDestination fails with transient errors and cancels uncommitted rows on rollback,
+// rows with the rejected id fail with a permanent error
+type recoverableDataDestination struct {
+	memoryDataDestination
+	rejected         push.Value
+	transientWrites  int
+	transientCommits int
+	rollbacks        int
+	uncommitted      []push.Row
+	rows             []push.Row
+}
+
+func (rdd *recoverableDataDestination) RowWriter(table push.Table) (push.RowWriter, *push.Error) {
+	return rdd, nil
+}
+
+func (rdd *recoverableDataDestination) Write(row push.Row, where push.Row) *push.Error {
+	if rdd.transientWrites > 0 {
+		rdd.transientWrites--
+		return &push.Error{Description: "deadlock", Transient: true}
+	}
+	if rdd.rejected != nil && row["id"] == rdd.rejected {
+		return &push.Error{Description: "duplicate key", Code: "23505", Constraint: "a_pkey"}
+	}
+	rdd.uncommitted = append(rdd.uncommitted, row)
+	return nil
+}
+
+func (rdd *recoverableDataDestination) Commit() *push.Error {
+	if rdd.transientCommits > 0 {
+		rdd.transientCommits--
+		return &push.Error{Description: "connection reset", Transient: true}
+	}
+	rdd.rows = append(rdd.rows, rdd.uncommitted...)
+	rdd.uncommitted = nil
+	return rdd.memoryDataDestination.Commit()
+}
+
+func (rdd *recoverableDataDestination) Rollback(reconnect bool) *push.Error {
+	rdd.rollbacks++
+	rdd.uncommitted = nil
+	return nil
+}
@@ -22,11 +22,14 @@
 	"errors"
 	"fmt"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/rs/zerolog/log"
 )
 
+var savepointMutex sync.Mutex
+
 // PushConfig holds configuration for the push operation
 type PushConfig struct {
 	CommitSize         uint
@@ -36,6 +39,7 @@ type PushConfig struct {
 	WhereClause        string
 	SavepointPath      string
 	AutoTruncate       bool
+	Retry              RetryPolicy
 }
 
 // pushContext encapsulates the state of a push operation
@@ -50,10 +54,14 @@ type pushContext struct {
 
 	committed  []Row
 	inputCount uint
+	pending    []pendingRow
+	counts     lineCounts
+	stats      *DestinationStats
+	prepared   bool // tables truncated and constraints disabled by the coordinator of parallel workers
 }
 
 // Push write rows to target table
-func Push(ri RowIterator, destination DataDestination, plan Plan, mode Mode, commitSize uint, commitTimeout time.Duration, disableConstraints bool, catchError RowWriter, translator Translator, whereField string, whereClause string, savepointPath string, autotruncate bool, observers ...Observer) *Error {
+func Push(ri RowIterator, destination DataDestination, plan Plan, mode Mode, commitSize uint, commitTimeout time.Duration, disableConstraints bool, catchError RowWriter, translator Translator, whereField string, whereClause string, savepointPath string, autotruncate bool, retry RetryPolicy, observers ...Observer) *Error {
 	cfg := PushConfig{
 		CommitSize:         commitSize,
 		CommitTimeout:      commitTimeout,
@@ -62,6 +70,7 @@ func Push(ri RowIterator, destination DataDestination, plan Plan, mode Mode, com
 		WhereClause:        whereClause,
 		SavepointPath:      savepointPath,
 		AutoTruncate:       autotruncate,
+		Retry:              retry,
 	}
 
 	ctx := &pushContext{
@@ -73,8 +82,11 @@ func Push(ri RowIterator, destination DataDestination, plan Plan, mode Mode, com
 		translator:  translator,
 		observers:   observers,
 		committed:   make([]Row, 0, commitSize),
+		counts:      newLineCounts(),
 	}
 
+	Reset()
+
 	return ctx.Run(ri)
 }
 
@@ -85,7 +97,15 @@ func (ctx *pushContext) Run(ri RowIterator) (err *Error) {
 		Str("url", ctx.destination.SafeUrl()).
 		Msg("Open database")
 
-	if err := ctx.destination.Open(ctx.plan, ctx.mode, ctx.cfg.DisableConstraints, ctx.cfg.WhereClause); err != nil {
+	mode, disableConstraints := ctx.mode, ctx.cfg.DisableConstraints
+	if ctx.prepared {
+		if mode == Truncate {
+			mode = Insert
+		}
+		disableConstraints = false
+	}
+
+	if err := ctx.destination.Open(ctx.plan, mode, disableConstraints, ctx.cfg.WhereClause); err != nil {
 		return err
 	}
 
@@ -93,8 +113,6 @@ func (ctx *pushContext) Run(ri RowIterator) (err *Error) {
 		err = ctx.cleanup(ri, err)
 	}()
 
-	Reset()
-
 	// Handle savepoint on exit
 	defer func() {
 		if ctx.cfg.SavepointPath != "" {
@@ -190,14 +208,31 @@ func (ctx *pushContext) processLoop(ri RowIterator) *Error {
 	return nil
 }
 
-func (ctx *pushContext) startRowReader(ri RowIterator) (<-chan *Row, <-chan *Error, chan struct{}) {
-	rowChan := make(chan *Row)
+// inputRow is a row read from the input with its line number
+type inputRow struct {
+	row  Row
+	line uint
+}
+
+// lineRowIterator is a RowIterator knowing the line number of the current row in the original input.
+type lineRowIterator interface {
+	RowIterator
+	Line() uint
+}
+
+func (ctx *pushContext) startRowReader(ri RowIterator) (<-chan *inputRow, <-chan *Error, chan struct{}) {
+	rowChan := make(chan *inputRow)
 	errChan := make(chan *Error, 1)
 	quit := make(chan struct{})
 
 	go func() {
 		defer close(rowChan)
+		line := uint(0)
 		for ri.Next() {
+			line++
+			if lri, ok := ri.(lineRowIterator); ok {
+				line = lri.Line()
+			}
 			val := ri.Value()
 			// Shallow copy to avoid race conditions
 			newRow := make(Row, len(*val))
@@ -205,7 +240,7 @@ func (ctx *pushContext) startRowReader(ri RowIterator) (<-chan *Row, <-chan *Err
 				newRow[k] = v
 			}
 			select {
-			case rowChan <- &newRow:
+			case rowChan <- &inputRow{row: newRow, line: line}:
 			case <-quit:
 				return
 			}
@@ -222,18 +257,27 @@ func (ctx *pushContext) startRowReader(ri RowIterator) (<-chan *Row, <-chan *Err
 	return rowChan, errChan, quit
 }
 
-func (ctx *pushContext) processRow(row *Row) *Error {
-	err := pushRow(*row, ctx.destination, ctx.plan.FirstTable(), ctx.plan, ctx.mode, ctx.translator, ctx.cfg.WhereField)
-	if err != nil {
-		if errWrite := ctx.catchError.Write(*row, nil); errWrite != nil {
-			return &Error{Description: fmt.Sprintf("%s (%s)", err.Error(), errWrite.Error())}
+func (ctx *pushContext) processRow(input *inputRow) *Error {
+	err := pushRow(input.row, ctx.destination, ctx.plan.FirstTable(), ctx.plan, ctx.mode, ctx.translator, ctx.cfg.WhereField, ctx.counts)
+	if err != nil && err.Transient && ctx.retryable() {
+		ctx.pending = append(ctx.pending, pendingRow{inputRow: *input})
+		if err := ctx.retry(err, false); err != nil {
+			return err
+		}
+	} else {
+		if err != nil {
+			if err := ctx.capture(input, err); err != nil {
+				return err
+			}
+		}
+		if ctx.retryable() {
+			ctx.pending = append(ctx.pending, pendingRow{inputRow: *input, caught: err != nil})
 		}
-		log.Warn().Msg(fmt.Sprintf("Error catched : %s", err.Error()))
 	}
 
 	ctx.inputCount++
 	if ctx.cfg.SavepointPath != "" {
-		ctx.committed = append(ctx.committed, extractValues(*row, ctx.plan.FirstTable().PrimaryKey()))
+		ctx.committed = append(ctx.committed, extractValues(input.row, ctx.plan.FirstTable().PrimaryKey()))
 	}
 
 	if ctx.inputCount%ctx.cfg.CommitSize == 0 {
@@ -244,6 +288,9 @@ func (ctx *pushContext) processRow(row *Row) *Error {
 	}
 
 	IncInputLinesCount()
+	if ctx.stats != nil {
+		ctx.stats.InputLinesCount++
+	}
 	for _, observer := range ctx.observers {
 		if observer != nil {
 			observer.Pushed()
@@ -252,6 +299,32 @@ func (ctx *pushContext) processRow(row *Row) *Error {
 	return nil
 }
 
+// capture writes the rejected input row to the error capture, with the description of the failure if supported
+func (ctx *pushContext) capture(input *inputRow, err *Error) *Error {
+	var errWrite *Error
+	if writer, ok := ctx.catchError.(ErrorRowWriter); ok {
+		errWrite = writer.WriteError(input.row, Failure{
+			Line:       input.line,
+			Table:      err.Table,
+			Mode:       ctx.mode,
+			Code:       err.Code,
+			Constraint: err.Constraint,
+			Message:    err.Description,
+		})
+	} else {
+		errWrite = ctx.catchError.Write(input.row, nil)
+	}
+
+	if errWrite != nil {
+		return &Error{Description: fmt.Sprintf("%s (%s)", err.Error(), errWrite.Error())}
+	}
+	if ctx.stats != nil {
+		ctx.stats.ErrorsCount++
+	}
+	log.Warn().Msg(fmt.Sprintf("Error catched : %s", err.Error()))
+	return nil
+}
+
 func (ctx *pushContext) handleTimeout() *Error {
 	if ctx.inputCount%ctx.cfg.CommitSize != 0 {
 		log.Info().Msg("Timeout commit")
@@ -262,8 +335,14 @@ func (ctx *pushContext) handleTimeout() *Error {
 
 func (ctx *pushContext) commit() *Error {
 	if err := ctx.destination.Commit(); err != nil {
-		return err
+		if !err.Transient || !ctx.retryable() {
+			return err
+		}
+		if err := ctx.retry(err, true); err != nil {
+			return err
+		}
 	}
+	ctx.pending = ctx.pending[:0]
 	if ctx.cfg.SavepointPath != "" {
 		if err := savepoint(ctx.cfg.SavepointPath, ctx.committed); err != nil {
 			// Restore previous behavior: log savepoint failures but do not make them fatal.
@@ -277,7 +356,12 @@ func (ctx *pushContext) commit() *Error {
 			ctx.committed = ctx.committed[:0]
 		}
 	}
+	addLinesCounts(ctx.counts)
+	ctx.counts = newLineCounts()
 	IncCommitsCount()
+	if ctx.stats != nil {
+		ctx.stats.CommitsCount++
+	}
 	return nil
 }
 
@@ -361,7 +445,7 @@ func FilterRelation(row Row, relations map[string]Relation, whereField string) (
 }
 
 // pushRow push a row in a specific table
-func pushRow(row Row, ds DataDestination, table Table, plan Plan, mode Mode, translator Translator, whereField string) *Error {
+func pushRow(row Row, ds DataDestination, table Table, plan Plan, mode Mode, translator Translator, whereField string, counts lineCounts) *Error {
 	frow, fwhere, frel, fInverseRel, err1 := FilterRelation(row, plan.RelationsFromTable(table), whereField)
 	if err1 != nil {
 		return err1
@@ -395,7 +479,7 @@ func pushRow(row Row, ds DataDestination, table Table, plan Plan, mode Mode, tra
 		for relName, subArray := range fInverseRel {
 			for _, subRow := range subArray {
 				rel := plan.RelationsFromTable(table)[relName]
-				err5 := pushRow(subRow, ds, rel.OppositeOf(table), plan, mode, translator, whereField)
+				err5 := pushRow(subRow, ds, rel.OppositeOf(table), plan, mode, translator, whereField, counts)
 				if err5 != nil {
 					return err5
 				}
@@ -405,16 +489,16 @@ func pushRow(row Row, ds DataDestination, table Table, plan Plan, mode Mode, tra
 		// Current table
 		err3 := rw.Write(frow, where)
 
-		IncDeletedLinesCount(table.Name())
+		counts.deleted[table.Name()]++
 
 		if err3 != nil {
-			return err3
+			return withTable(err3, table)
 		}
 
 		// and parents
 		for relName, subRow := range frel {
 			rel := plan.RelationsFromTable(table)[relName]
-			err4 := pushRow(subRow, ds, rel.OppositeOf(table), plan, mode, translator, whereField)
+			err4 := pushRow(subRow, ds, rel.OppositeOf(table), plan, mode, translator, whereField, counts)
 			if err4 != nil {
 				return err4
 			}
@@ -423,26 +507,32 @@ func pushRow(row Row, ds DataDestination, table Table, plan Plan, mode Mode, tra
 		// parent first
 		for relName, subRow := range frel {
 			rel := plan.RelationsFromTable(table)[relName]
-			err4 := pushRow(subRow, ds, rel.OppositeOf(table), plan, mode, translator, whereField)
+			err4 := pushRow(subRow, ds, rel.OppositeOf(table), plan, mode, translator, whereField, counts)
 			if err4 != nil {
 				return err4
 			}
 		}
 
 		// current
-		err3 := rw.Write(frow, where)
+		var err3 *Error
+		if recorder, ok := translator.(KeyRecorder); ok {
+			remapForeignKeys(frow, table, plan, recorder)
+			err3 = writeGeneratingKey(rw, frow, where, table, recorder)
+		} else {
+			err3 = rw.Write(frow, where)
+		}
 
-		IncCreatedLinesCount(table.Name())
+		counts.created[table.Name()]++
 
 		if err3 != nil {
-			return err3
+			return withTable(err3, table)
 		}
 
 		// and children
 		for relName, subArray := range fInverseRel {
 			for _, subRow := range subArray {
 				rel := plan.RelationsFromTable(table)[relName]
-				err5 := pushRow(subRow, ds, rel.OppositeOf(table), plan, mode, translator, whereField)
+				err5 := pushRow(subRow, ds, rel.OppositeOf(table), plan, mode, translator, whereField, counts)
 				if err5 != nil {
 					return err5
 				}
@@ -453,6 +543,73 @@ func pushRow(row Row, ds DataDestination, table Table, plan Plan, mode Mode, tra
 	return nil
 }
 
+// withTable sets the table of the failed write, if not already set by the datadestination
+func withTable(err *Error, table Table) *Error {
+	if err.Table == "" {
+		err.Table = table.Name()
+	}
+	return err
+}
+
+// remapForeignKeys replaces the values of the foreign keys referencing a parent key generated by the database
+func remapForeignKeys(row Row, table Table, plan Plan, recorder KeyRecorder) {
+	for _, rel := range plan.RelationsFromTable(table) {
+		if rel.Child() == nil || rel.Parent() == nil || rel.Child().Name() != table.Name() {
+			continue
+		}
+
+		parentKeys, childKeys := rel.ParentKeys(), rel.ChildKeys()
+		for i := 0; i < len(childKeys) && i < len(parentKeys); i++ {
+			value, exists := row[childKeys[i]]
+			if !exists {
+				continue
+			}
+
+			if generated, ok := recorder.Generated(Key{rel.Parent().Name(), parentKeys[i]}, value); ok {
+				row[childKeys[i]] = generated
+			}
+		}
+	}
+}
+
+// writeGeneratingKey writes row and records the primary key value generated by the database, if any
+func writeGeneratingKey(rw RowWriter, row Row, where Row, table Table, recorder KeyRecorder) *Error {
+	var key string
+
+	for _, pkname := range table.PrimaryKey() {
+		if recorder.IsGenerated(Key{table.Name(), pkname}) {
+			key = pkname
+			break
+		}
+	}
+
+	if key == "" {
+		return rw.Write(row, where)
+	}
+
+	value := row[key]
+	if _, exists := recorder.Generated(Key{table.Name(), key}, value); exists {
+		// already inserted by a previous object
+		return nil
+	}
+
+	krw, ok := rw.(GeneratedKeyRowWriter)
+	if !ok {
+		return &Error{Description: fmt.Sprintf("datadestination can't read back the key generated for %s.%s", table.Name(), key)}
+	}
+
+	delete(row, key)
+
+	generated, err := krw.WriteReturningKey(row, key)
+	if err != nil {
+		return err
+	}
+
+	recorder.Record(Key{table.Name(), key}, value, generated)
+
+	return nil
+}
+
 func computeTranslatedKeys(row Row, table Table, translator Translator) Row {
 	where := Row{}
 
@@ -468,6 +625,10 @@ func computeTranslatedKeys(row Row, table Table, translator Translator) Row {
 }
 
 func savepoint(savepointPath string, committed []Row) *Error {
+	// parallel workers share the same savepoint file
+	savepointMutex.Lock()
+	defer savepointMutex.Unlock()
+
 	f, err := os.OpenFile(savepointPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600) //nolint:gosec
 	if err != nil {
 		return &Error{Description: err.Error()}
@@ -0,0 +1,166 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Target is a datadestination of a fan-out push, with its own mode and error capture.
+type Target struct {
+	Name        string
+	Destination DataDestination
+	Mode        Mode
+	CatchError  RowWriter
+}
+
+// PushFanOut write each input row to every target, with one worker per target.
+// Each target commits the same batches of commitSize rows, and may lag behind the others by at most one batch.
+// A failing target stops the push, unless continueOnError is set : the target is then isolated, the others
+// receive the remaining rows and the failure is reported at the end of the push.
+func PushFanOut(ri RowIterator, targets []Target, plan Plan, commitSize uint, commitTimeout time.Duration, disableConstraints bool, translator Translator, whereField string, whereClause string, autotruncate bool, continueOnError bool, retry RetryPolicy, observers ...Observer) *Error { //nolint:lll
+	if len(targets) == 0 {
+		return &Error{Description: "no datadestination to push to"}
+	}
+
+	cfg := PushConfig{
+		CommitSize:         commitSize,
+		CommitTimeout:      commitTimeout,
+		DisableConstraints: disableConstraints,
+		WhereField:         whereField,
+		WhereClause:        whereClause,
+		AutoTruncate:       autotruncate,
+		Retry:              retry,
+	}
+
+	shared := &syncObserver{observers: observers}
+	defer shared.close()
+
+	Reset()
+
+	done := make(chan struct{})
+	inputs := make([]chan *inputRow, len(targets))
+	stopped := make([]chan struct{}, len(targets))
+	errs := make([]*Error, len(targets))
+	destinationStats := make(map[string]*DestinationStats, len(targets))
+	once := sync.Once{}
+	wg := &sync.WaitGroup{}
+
+	for i, target := range targets {
+		inputs[i] = make(chan *inputRow, commitSize)
+		stopped[i] = make(chan struct{})
+		destinationStats[target.Name] = &DestinationStats{Mode: target.Mode.String()}
+
+		ctx := &pushContext{
+			cfg:         cfg,
+			destination: target.Destination,
+			plan:        plan,
+			mode:        target.Mode,
+			catchError:  target.CatchError,
+			translator:  translator,
+			committed:   make([]Row, 0, commitSize),
+			counts:      newLineCounts(),
+			stats:       destinationStats[target.Name],
+		}
+
+		wg.Add(1)
+
+		go func(worker int, ctx *pushContext) {
+			defer wg.Done()
+			defer close(stopped[worker])
+
+			name := targets[worker].Name
+			log.Debug().Str("dataconnector", name).Msg("start push to destination")
+
+			if err := ctx.Run(&channelRowIterator{rows: inputs[worker]}); err != nil {
+				errs[worker] = &Error{Description: fmt.Sprintf("destination %s: %s", name, err.Error())}
+				ctx.stats.Error = err.Error()
+
+				if continueOnError {
+					log.Error().Str("dataconnector", name).AnErr("error", err).Msg("Destination isolated, push continues on other destinations")
+				} else {
+					once.Do(func() { close(done) })
+				}
+			}
+
+			log.Debug().Str("dataconnector", name).Msg("end push to destination")
+		}(i, ctx)
+	}
+
+	count, readErr := broadcast(ri, inputs, stopped, done, shared)
+
+	for _, input := range inputs {
+		close(input)
+	}
+
+	wg.Wait()
+
+	setInputLinesCount(count)
+	setDestinationStats(destinationStats)
+
+	return combineErrors(append(errs, readErr, ri.Close())...)
+}
+
+// broadcast sends each row to every running worker, until the end of the input, the failure of all workers
+// or the stop of the push. It returns the number of rows read.
+func broadcast(ri RowIterator, inputs []chan *inputRow, stopped []chan struct{}, done <-chan struct{}, observer Observer) (int, *Error) {
+	line := uint(0)
+	running := make([]bool, len(inputs))
+	remaining := len(inputs)
+
+	for i := range running {
+		running[i] = true
+	}
+
+	for ri.Next() {
+		line++
+		val := ri.Value()
+
+		row := make(Row, len(*val))
+		for k, v := range *val {
+			row[k] = v
+		}
+
+		for i, input := range inputs {
+			if !running[i] {
+				continue
+			}
+
+			select {
+			case input <- &inputRow{row: row, line: line}:
+			case <-stopped[i]:
+				running[i] = false
+				remaining--
+			case <-done:
+				return int(line), nil //nolint:gosec
+			}
+		}
+
+		if remaining == 0 {
+			return int(line), nil //nolint:gosec
+		}
+
+		observer.Pushed()
+	}
+
+	return int(line), ri.Error() //nolint:gosec
+}
@@ -0,0 +1,235 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// PushParallel write rows to target tables with one worker per datadestination.
+// Rows are partitioned by the hash of the start table primary key, so an object and its related rows are
+// always written by the same worker in the same transaction, parents before children.
+// In truncate mode or with disabled constraints, the coordinator truncates the tables and disables the constraints
+// once before the workers start, and restores the constraints once after they finish.
+func PushParallel(ri RowIterator, destinations []DataDestination, coordinator DataDestination, plan Plan, mode Mode, commitSize uint, commitTimeout time.Duration, disableConstraints bool, catchError RowWriter, translator Translator, whereField string, whereClause string, savepointPath string, autotruncate bool, retry RetryPolicy, observers ...Observer) *Error { //nolint:lll
+	if len(destinations) == 0 {
+		return &Error{Description: "no datadestination to push to"}
+	}
+
+	prepared := mode == Truncate || disableConstraints
+	if prepared && coordinator == nil {
+		return &Error{Description: "no coordinating datadestination to truncate the tables or disable the constraints"}
+	}
+
+	cfg := PushConfig{
+		CommitSize:         commitSize,
+		CommitTimeout:      commitTimeout,
+		DisableConstraints: disableConstraints,
+		WhereField:         whereField,
+		WhereClause:        whereClause,
+		SavepointPath:      savepointPath,
+		AutoTruncate:       autotruncate,
+		Retry:              retry,
+	}
+
+	shared := &syncObserver{observers: observers}
+	defer shared.close()
+
+	sharedCatchError := &syncRowWriter{writer: catchError}
+
+	Reset()
+
+	if prepared {
+		log.Info().
+			Str("url", coordinator.SafeUrl()).
+			Msg("Open coordinating database")
+
+		if err := coordinator.Open(plan, mode, disableConstraints, whereClause); err != nil {
+			return combineErrors(err, ri.Close())
+		}
+	}
+
+	done := make(chan struct{})
+	inputs := make([]chan *inputRow, len(destinations))
+	errs := make([]*Error, len(destinations))
+	once := sync.Once{}
+	wg := &sync.WaitGroup{}
+
+	for i, destination := range destinations {
+		inputs[i] = make(chan *inputRow)
+
+		ctx := &pushContext{
+			cfg:         cfg,
+			destination: destination,
+			plan:        plan,
+			mode:        mode,
+			catchError:  sharedCatchError,
+			translator:  translator,
+			observers:   []Observer{shared},
+			committed:   make([]Row, 0, commitSize),
+			counts:      newLineCounts(),
+			prepared:    prepared,
+		}
+
+		wg.Add(1)
+
+		go func(worker int, ctx *pushContext) {
+			defer wg.Done()
+
+			log.Debug().Int("worker", worker).Msg("start push worker")
+
+			if err := ctx.Run(&channelRowIterator{rows: inputs[worker]}); err != nil {
+				errs[worker] = err
+				once.Do(func() { close(done) })
+			}
+
+			log.Debug().Int("worker", worker).Msg("end push worker")
+		}(i, ctx)
+	}
+
+	readErr := dispatch(ri, inputs, plan.FirstTable().PrimaryKey(), done)
+
+	for _, input := range inputs {
+		close(input)
+	}
+
+	wg.Wait()
+
+	errs = append(errs, readErr, ri.Close())
+	if prepared {
+		errs = append(errs, coordinator.Close())
+	}
+
+	return combineErrors(errs...)
+}
+
+// dispatch sends each row to the worker owning the hash of its primary key, until the end of the input or a worker failure.
+func dispatch(ri RowIterator, inputs []chan *inputRow, keys []string, done <-chan struct{}) *Error {
+	next := 0
+	line := uint(0)
+
+	for ri.Next() {
+		line++
+		val := ri.Value()
+
+		row := make(Row, len(*val))
+		for k, v := range *val {
+			row[k] = v
+		}
+
+		worker := next % len(inputs)
+		if len(keys) > 0 {
+			worker = partition(row, keys, len(inputs))
+		} else {
+			next++
+		}
+
+		select {
+		case inputs[worker] <- &inputRow{row: row, line: line}:
+		case <-done:
+			return nil
+		}
+	}
+
+	return ri.Error()
+}
+
+// partition returns the index of the worker for the row.
+func partition(row Row, keys []string, count int) int {
+	hash := fnv.New32a()
+
+	for _, key := range keys {
+		fmt.Fprintf(hash, "%v\x00", row[key])
+	}
+
+	return int(hash.Sum32() % uint32(count)) //nolint:gosec
+}
+
+// channelRowIterator iterates over rows received by a worker, with their line number in the original input.
+type channelRowIterator struct {
+	rows  <-chan *inputRow
+	value *inputRow
+}
+
+func (ci *channelRowIterator) Next() bool {
+	row, ok := <-ci.rows
+	ci.value = row
+
+	return ok
+}
+
+func (ci *channelRowIterator) Value() *Row   { return &ci.value.row }
+func (ci *channelRowIterator) Line() uint    { return ci.value.line }
+func (ci *channelRowIterator) Error() *Error { return nil }
+func (ci *channelRowIterator) Close() *Error { return nil }
+
+// syncRowWriter serializes writes of parallel workers, used to catch errors in a single file.
+type syncRowWriter struct {
+	mutex  sync.Mutex
+	writer RowWriter
+}
+
+func (w *syncRowWriter) Write(row Row, where Row) *Error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	return w.writer.Write(row, where)
+}
+
+func (w *syncRowWriter) WriteError(row Row, failure Failure) *Error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if writer, ok := w.writer.(ErrorRowWriter); ok {
+		return writer.WriteError(row, failure)
+	}
+	return w.writer.Write(row, nil)
+}
+
+// syncObserver serializes notifications of parallel workers, observers are closed once at the end of the push.
+type syncObserver struct {
+	mutex     sync.Mutex
+	observers []Observer
+}
+
+func (o *syncObserver) Pushed() {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	for _, observer := range o.observers {
+		if observer != nil {
+			observer.Pushed()
+		}
+	}
+}
+
+// Close is called by each worker and does nothing.
+func (o *syncObserver) Close() {}
+
+func (o *syncObserver) close() {
+	for _, observer := range o.observers {
+		if observer != nil {
+			observer.Close()
+		}
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// RetryPolicy configures how a commit batch is retried after a transient error
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts of a commit batch, 0 or 1 disables retries
+	MaxAttempts uint
+	// Backoff is the wait before the first retry, doubled on each following retry
+	Backoff time.Duration
+	// MaxBackoff caps the wait between two retries, 0 means no limit
+	MaxBackoff time.Duration
+	// Reconnect opens a new connection to the datadestination before retrying
+	Reconnect bool
+}
+
+// pendingRow is a row written since the last commit
+type pendingRow struct {
+	inputRow
+	caught bool // already sent to the error capture
+}
+
+// retryable returns true if the commit batch can be replayed after a transient error
+func (ctx *pushContext) retryable() bool {
+	if ctx.cfg.Retry.MaxAttempts <= 1 {
+		return false
+	}
+	_, ok := ctx.destination.(RecoverableDataDestination)
+	return ok
+}
+
+// retry rolls back the current commit batch and replays it with exponential backoff until it succeeds,
+// a permanent error occurs or the max attempts are reached. The batch is committed if commit is true.
+func (ctx *pushContext) retry(cause *Error, commit bool) *Error {
+	destination := ctx.destination.(RecoverableDataDestination)
+	backoff := ctx.cfg.Retry.Backoff
+
+	for attempt := uint(2); attempt <= ctx.cfg.Retry.MaxAttempts; attempt++ {
+		log.Warn().
+			AnErr("error", cause).
+			Uint("attempt", attempt).
+			Dur("backoff", backoff).
+			Int("rows", len(ctx.pending)).
+			Msg("Transient error, retrying commit batch")
+
+		time.Sleep(backoff)
+
+		backoff *= 2
+		if ctx.cfg.Retry.MaxBackoff > 0 && backoff > ctx.cfg.Retry.MaxBackoff {
+			backoff = ctx.cfg.Retry.MaxBackoff
+		}
+
+		// lines written by the rolled back batch are counted again by the replay
+		ctx.counts = newLineCounts()
+
+		if cause = destination.Rollback(ctx.cfg.Retry.Reconnect); cause == nil {
+			if cause = ctx.replay(commit); cause == nil {
+				return nil
+			}
+		}
+
+		if !cause.Transient {
+			return cause
+		}
+	}
+
+	return &Error{Description: fmt.Sprintf("%s (after %d attempts)", cause.Error(), ctx.cfg.Retry.MaxAttempts)}
+}
+
+// replay writes again the rows of the commit batch, permanent errors are sent to the error capture
+func (ctx *pushContext) replay(commit bool) *Error {
+	for i := range ctx.pending {
+		pending := &ctx.pending[i]
+		if pending.caught {
+			continue
+		}
+
+		err := pushRow(pending.row, ctx.destination, ctx.plan.FirstTable(), ctx.plan, ctx.mode, ctx.translator, ctx.cfg.WhereField, ctx.counts)
+		if err == nil {
+			continue
+		}
+
+		if err.Transient {
+			return err
+		}
+
+		if err := ctx.capture(&pending.inputRow, err); err != nil {
+			return err
+		}
+		pending.caught = true
+	}
+
+	if commit {
+		return ctx.destination.Commit()
+	}
+
+	return nil
+}
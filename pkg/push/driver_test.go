@@ -51,9 +51,9 @@ func TestSimplePush(t *testing.T) {
 		B.Name(): {},
 		C.Name(): {},
 	}
-	dest := memoryDataDestination{tables, false, false, false, 0}
+	dest := memoryDataDestination{tables: tables}
 
-	err := push.Push(&ri, &dest, plan, push.Insert, 2, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false)
+	err := push.Push(&ri, &dest, plan, push.Insert, 2, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false, push.RetryPolicy{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, true, dest.closed)
@@ -90,9 +90,9 @@ func TestRelationPush(t *testing.T) {
 		B.Name(): {},
 		C.Name(): {},
 	}
-	dest := memoryDataDestination{tables, false, false, false, 0}
+	dest := memoryDataDestination{tables: tables}
 
-	err := push.Push(&ri, &dest, plan, push.Insert, 2, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false)
+	err := push.Push(&ri, &dest, plan, push.Insert, 2, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false, push.RetryPolicy{})
 
 	// no error
 	assert.Nil(t, err)
@@ -139,9 +139,9 @@ func TestRelationPushWithEmptyRelation(t *testing.T) {
 		B.Name(): {},
 		C.Name(): {},
 	}
-	dest := memoryDataDestination{tables, false, false, false, 0}
+	dest := memoryDataDestination{tables: tables}
 
-	err := push.Push(&ri, &dest, plan, push.Insert, 2, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false)
+	err := push.Push(&ri, &dest, plan, push.Insert, 2, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false, push.RetryPolicy{})
 
 	// no error
 	assert.Nil(t, err)
@@ -190,9 +190,9 @@ func TestInversseRelationPush(t *testing.T) {
 		B.Name(): {},
 		C.Name(): {},
 	}
-	dest := memoryDataDestination{tables, false, false, false, 0}
+	dest := memoryDataDestination{tables: tables}
 
-	err := push.Push(&ri, &dest, plan, push.Insert, 5, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false)
+	err := push.Push(&ri, &dest, plan, push.Insert, 5, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false, push.RetryPolicy{})
 
 	// no error
 	assert.Nil(t, err)
@@ -231,7 +231,7 @@ func TestPushWithCommitTimeout(t *testing.T) {
 	dest := &memoryDataDestination{tables: tables}
 
 	// Commit size 10, but timeout 100ms. Should trigger commit after first row due to delay.
-	err := push.Push(ri, dest, plan, push.Insert, 10, 100*time.Millisecond, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false)
+	err := push.Push(ri, dest, plan, push.Insert, 10, 100*time.Millisecond, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false, push.RetryPolicy{})
 
 	assert.Nil(t, err)
 	// Should have 2 commits: 1 for timeout after first row, 1 final commit for second row
@@ -253,7 +253,7 @@ func TestPushWithSavepoint(t *testing.T) {
 	dest := &memoryDataDestination{tables: tables}
 
 	// Commit size 2, total 5 rows -> 2 intermediate commits
-	err = push.Push(&ri, dest, plan, push.Insert, 2, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", tmpfile.Name(), false)
+	err = push.Push(&ri, dest, plan, push.Insert, 2, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", tmpfile.Name(), false, push.RetryPolicy{})
 
 	assert.Nil(t, err)
 
@@ -272,7 +272,7 @@ func TestPushWithObservers(t *testing.T) {
 	dest := &memoryDataDestination{tables: tables}
 
 	obs := &mockObserver{}
-	err := push.Push(&ri, dest, plan, push.Insert, 10, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false, obs)
+	err := push.Push(&ri, dest, plan, push.Insert, 10, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false, push.RetryPolicy{}, obs)
 
 	assert.Nil(t, err)
 	assert.Equal(t, 5, obs.pushedCount)
@@ -329,7 +329,7 @@ func TestPushWithEmptyIterator(t *testing.T) {
 	tables := map[string]*rowWriter{A.Name(): {}}
 	dest := &memoryDataDestination{tables: tables}
 
-	err := push.Push(&ri, dest, plan, push.Insert, 10, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false)
+	err := push.Push(&ri, dest, plan, push.Insert, 10, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false, push.RetryPolicy{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, 0, len(dest.tables[A.Name()].rows))
@@ -344,7 +344,7 @@ func TestPushWithExactCommitSize(t *testing.T) {
 	tables := map[string]*rowWriter{A.Name(): {}}
 	dest := &memoryDataDestination{tables: tables}
 
-	err := push.Push(&ri, dest, plan, push.Insert, 5, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false)
+	err := push.Push(&ri, dest, plan, push.Insert, 5, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false, push.RetryPolicy{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, 5, len(dest.tables[A.Name()].rows))
@@ -360,7 +360,7 @@ func TestPushWithCommitSizeOne(t *testing.T) {
 	tables := map[string]*rowWriter{A.Name(): {}}
 	dest := &memoryDataDestination{tables: tables}
 
-	err := push.Push(&ri, dest, plan, push.Insert, 1, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false)
+	err := push.Push(&ri, dest, plan, push.Insert, 1, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false, push.RetryPolicy{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, 3, len(dest.tables[A.Name()].rows))
@@ -378,7 +378,7 @@ func TestPushWithIteratorError(t *testing.T) {
 	tables := map[string]*rowWriter{A.Name(): {}}
 	dest := &memoryDataDestination{tables: tables}
 
-	err := push.Push(ri, dest, plan, push.Insert, 10, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false)
+	err := push.Push(ri, dest, plan, push.Insert, 10, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false, push.RetryPolicy{})
 
 	assert.NotNil(t, err)
 	assert.Equal(t, "iterator error", err.Description)
@@ -391,7 +391,7 @@ func TestPushWithDestinationOpenError(t *testing.T) {
 	ri := rowIterator{limit: 5, row: push.Row{"name": "John"}}
 	dest := &errorDataDestination{failOnOpen: true}
 
-	err := push.Push(&ri, dest, plan, push.Insert, 10, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false)
+	err := push.Push(&ri, dest, plan, push.Insert, 10, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false, push.RetryPolicy{})
 
 	assert.NotNil(t, err)
 	assert.Equal(t, "open error", err.Description)
@@ -408,7 +408,7 @@ func TestPushWithCommitError(t *testing.T) {
 		commitToFail: 1,
 	}
 
-	err := push.Push(&ri, dest, plan, push.Insert, 2, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false)
+	err := push.Push(&ri, dest, plan, push.Insert, 2, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false, push.RetryPolicy{})
 
 	assert.NotNil(t, err)
 	assert.Equal(t, "commit error", err.Description)
@@ -431,7 +431,7 @@ func TestPushWithMultipleTimeouts(t *testing.T) {
 	tables := map[string]*rowWriter{A.Name(): {}}
 	dest := &memoryDataDestination{tables: tables}
 
-	err := push.Push(ri, dest, plan, push.Insert, 10, 100*time.Millisecond, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false)
+	err := push.Push(ri, dest, plan, push.Insert, 10, 100*time.Millisecond, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false, push.RetryPolicy{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, 3, len(dest.tables[A.Name()].rows))
@@ -456,7 +456,7 @@ func TestPushTimeoutWithExactCommitSize(t *testing.T) {
 	dest := &memoryDataDestination{tables: tables}
 
 	// commitSize = 2, so after 2 rows we hit the size limit
-	err := push.Push(ri, dest, plan, push.Insert, 2, 100*time.Millisecond, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false)
+	err := push.Push(ri, dest, plan, push.Insert, 2, 100*time.Millisecond, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false, push.RetryPolicy{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(dest.tables[A.Name()].rows))
@@ -566,7 +566,7 @@ func TestPushUpdateModeWithTranslator(t *testing.T) {
 		},
 	}
 
-	err := push.Push(&ri, dest, plan, push.Update, 10, 0, true, push.NoErrorCaptureRowWriter{}, translator, "", "", "", false)
+	err := push.Push(&ri, dest, plan, push.Update, 10, 0, true, push.NoErrorCaptureRowWriter{}, translator, "", "", "", false, push.RetryPolicy{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(dest.tables[A.Name()].rows))
@@ -580,7 +580,7 @@ func TestPushDeleteMode(t *testing.T) {
 	tables := map[string]*rowWriter{A.Name(): {}}
 	dest := &memoryDataDestination{tables: tables}
 
-	err := push.Push(&ri, dest, plan, push.Delete, 10, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false)
+	err := push.Push(&ri, dest, plan, push.Delete, 10, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false, push.RetryPolicy{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, 3, len(dest.tables[A.Name()].rows))
@@ -596,7 +596,7 @@ func TestPushWithDestinationCloseError(t *testing.T) {
 		failOnClose: true,
 	}
 
-	err := push.Push(&ri, dest, plan, push.Insert, 10, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false)
+	err := push.Push(&ri, dest, plan, push.Insert, 10, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false, push.RetryPolicy{})
 
 	assert.NotNil(t, err)
 	assert.Equal(t, "close error", err.Description)
@@ -614,7 +614,7 @@ func TestPushWithIteratorCloseError(t *testing.T) {
 	tables := map[string]*rowWriter{A.Name(): {}}
 	dest := &memoryDataDestination{tables: tables}
 
-	err := push.Push(ri, dest, plan, push.Insert, 10, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false)
+	err := push.Push(ri, dest, plan, push.Insert, 10, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false, push.RetryPolicy{})
 
 	assert.NotNil(t, err)
 	assert.Equal(t, "close error", err.Description)
@@ -634,7 +634,7 @@ func TestPushWithMultipleCloseErrors(t *testing.T) {
 		failOnClose: true,
 	}
 
-	err := push.Push(ri, dest, plan, push.Insert, 10, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false)
+	err := push.Push(ri, dest, plan, push.Insert, 10, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false, push.RetryPolicy{})
 
 	assert.NotNil(t, err)
 	assert.Contains(t, err.Description, "close error")
@@ -654,7 +654,7 @@ func TestPushWithRowWriteError(t *testing.T) {
 	// Catch error writer
 	errorWriter := &captureRowWriter{}
 
-	err := push.Push(&ri, dest, plan, push.Insert, 10, 0, true, errorWriter, nil, "", "", "", false)
+	err := push.Push(&ri, dest, plan, push.Insert, 10, 0, true, errorWriter, nil, "", "", "", false, push.RetryPolicy{})
 
 	assert.Nil(t, err) // Should not fail, errors are caught
 	assert.Equal(t, 2, len(errorWriter.rows), "Should have caught 2 errors")
@@ -669,7 +669,7 @@ func TestPushWithSavepointWriteError(t *testing.T) {
 	dest := &memoryDataDestination{tables: tables}
 
 	// Use invalid path to trigger savepoint error
-	err := push.Push(&ri, dest, plan, push.Insert, 2, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "/invalid/path/savepoint.json", "", false)
+	err := push.Push(&ri, dest, plan, push.Insert, 2, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "/invalid/path/savepoint.json", "", false, push.RetryPolicy{})
 
 	assert.Nil(t, err) // Savepoint failure should be non-fatal
 }
@@ -772,7 +772,7 @@ func TestPushWithInvalidRelationArrayElement(t *testing.T) {
 	dest := &memoryDataDestination{tables: tables}
 	errorWriter := &captureRowWriter{}
 
-	err := push.Push(ri, dest, plan, push.Insert, 10, 0, true, errorWriter, nil, "", "", "", false)
+	err := push.Push(ri, dest, plan, push.Insert, 10, 0, true, errorWriter, nil, "", "", "", false, push.RetryPolicy{})
 
 	assert.Nil(t, err) // Error should be caught
 	assert.Equal(t, 1, len(errorWriter.rows))
@@ -798,7 +798,7 @@ func TestPushWithInvalidRelationType(t *testing.T) {
 	dest := &memoryDataDestination{tables: tables}
 	errorWriter := &captureRowWriter{}
 
-	err := push.Push(ri, dest, plan, push.Insert, 10, 0, true, errorWriter, nil, "", "", "", false)
+	err := push.Push(ri, dest, plan, push.Insert, 10, 0, true, errorWriter, nil, "", "", "", false, push.RetryPolicy{})
 
 	assert.Nil(t, err) // Error should be caught
 	assert.Equal(t, 1, len(errorWriter.rows))
@@ -821,7 +821,7 @@ func TestPushWithWhereField(t *testing.T) {
 	tables := map[string]*rowWriter{A.Name(): {}}
 	dest := &memoryDataDestination{tables: tables}
 
-	err := push.Push(ri, dest, plan, push.Update, 10, 0, true, push.NoErrorCaptureRowWriter{}, nil, "__usingpk__", "", "", false)
+	err := push.Push(ri, dest, plan, push.Update, 10, 0, true, push.NoErrorCaptureRowWriter{}, nil, "__usingpk__", "", "", false, push.RetryPolicy{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, 1, len(dest.tables[A.Name()].rows))
@@ -849,7 +849,7 @@ func TestPushWithNoImportColumns(t *testing.T) {
 	tables := map[string]*rowWriter{A.Name(): {}}
 	dest := &memoryDataDestination{tables: tables}
 
-	err := push.Push(ri, dest, plan, push.Insert, 10, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false)
+	err := push.Push(ri, dest, plan, push.Insert, 10, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false, push.RetryPolicy{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, 1, len(dest.tables[A.Name()].rows))
@@ -866,7 +866,7 @@ func TestPushTruncateMode(t *testing.T) {
 	tables := map[string]*rowWriter{A.Name(): {}}
 	dest := &memoryDataDestination{tables: tables}
 
-	err := push.Push(&ri, dest, plan, push.Truncate, 10, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false)
+	err := push.Push(&ri, dest, plan, push.Truncate, 10, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false, push.RetryPolicy{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(dest.tables[A.Name()].rows))
@@ -892,7 +892,7 @@ func TestPushUpdateModeWithRelations(t *testing.T) {
 	tables := map[string]*rowWriter{A.Name(): {}, B.Name(): {}}
 	dest := &memoryDataDestination{tables: tables}
 
-	err := push.Push(ri, dest, plan, push.Update, 10, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false)
+	err := push.Push(ri, dest, plan, push.Update, 10, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false, push.RetryPolicy{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, 1, len(dest.tables[A.Name()].rows))
@@ -919,7 +919,7 @@ func TestPushDeleteModeWithRelations(t *testing.T) {
 	tables := map[string]*rowWriter{A.Name(): {}, B.Name(): {}}
 	dest := &memoryDataDestination{tables: tables}
 
-	err := push.Push(ri, dest, plan, push.Delete, 10, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false)
+	err := push.Push(ri, dest, plan, push.Delete, 10, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false, push.RetryPolicy{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, 1, len(dest.tables[A.Name()].rows))
@@ -951,7 +951,7 @@ func TestPushUpdateModeWithInverseRelations(t *testing.T) {
 	tables := map[string]*rowWriter{A.Name(): {}, B.Name(): {}, C.Name(): {}}
 	dest := &memoryDataDestination{tables: tables}
 
-	err := push.Push(ri, dest, plan, push.Update, 10, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false)
+	err := push.Push(ri, dest, plan, push.Update, 10, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false, push.RetryPolicy{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, 1, len(dest.tables[A.Name()].rows))
@@ -995,7 +995,7 @@ func TestStatsComputation(t *testing.T) {
 
 	push.Reset()
 
-	err := push.Push(&ri, dest, plan, push.Insert, 2, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false)
+	err := push.Push(&ri, dest, plan, push.Insert, 2, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false, push.RetryPolicy{})
 
 	assert.Nil(t, err)
 
@@ -1020,7 +1020,7 @@ func TestDeleteStats(t *testing.T) {
 
 	push.Reset()
 
-	err := push.Push(&ri, dest, plan, push.Delete, 10, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false)
+	err := push.Push(&ri, dest, plan, push.Delete, 10, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false, push.RetryPolicy{})
 
 	assert.Nil(t, err)
 
@@ -1090,3 +1090,299 @@ func TestTableGetColumn(t *testing.T) {
 	col = A.GetColumn("nonexistent")
 	assert.Nil(t, col)
 }
+
+func TestPushParallel(t *testing.T) {
+	A := push.NewTable("A", []string{"id"}, nil)
+	plan := push.NewPlan(A, []push.Relation{})
+
+	rows := []push.Row{}
+	for i := 0; i < 20; i++ {
+		rows = append(rows, push.Row{"id": i % 10, "name": fmt.Sprintf("name%d", i)})
+	}
+	ri := &delayedRowIterator{rows: rows}
+
+	destinations := []push.DataDestination{}
+	memoryDestinations := []*memoryDataDestination{}
+	for i := 0; i < 3; i++ {
+		dest := &memoryDataDestination{tables: map[string]*rowWriter{A.Name(): {}}}
+		destinations = append(destinations, dest)
+		memoryDestinations = append(memoryDestinations, dest)
+	}
+
+	savepointFile, err := os.CreateTemp("", "savepoint")
+	assert.Nil(t, err)
+	defer os.Remove(savepointFile.Name())
+
+	obs := &mockObserver{}
+	e := push.PushParallel(ri, destinations, nil, plan, push.Insert, 3, 0, false, push.NoErrorCaptureRowWriter{}, nil, "", "", savepointFile.Name(), false, push.RetryPolicy{}, obs)
+	assert.Nil(t, e)
+
+	total := 0
+	owner := map[interface{}]int{}
+	for i, dest := range memoryDestinations {
+		assert.True(t, dest.opened)
+		assert.True(t, dest.closed)
+		for _, row := range dest.tables[A.Name()].rows {
+			if previous, exists := owner[row["id"]]; exists {
+				assert.Equal(t, previous, i, "rows with the same key must be pushed by the same worker")
+			}
+			owner[row["id"]] = i
+			total++
+		}
+	}
+
+	assert.Equal(t, 20, total)
+	assert.Equal(t, 20, obs.pushedCount)
+	assert.True(t, obs.closed)
+
+	content, err := os.ReadFile(savepointFile.Name())
+	assert.Nil(t, err)
+	assert.Len(t, strings.Split(strings.TrimSpace(string(content)), "\n"), 20)
+}
+
+func TestPushParallelTruncate(t *testing.T) {
+	A := push.NewTable("A", []string{"id"}, nil)
+	plan := push.NewPlan(A, []push.Relation{})
+
+	for _, tt := range []struct {
+		name               string
+		mode               push.Mode
+		disableConstraints bool
+	}{
+		{"truncate", push.Truncate, false},
+		{"disable constraints", push.Insert, true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ri := &delayedRowIterator{rows: []push.Row{{"id": 1}, {"id": 2}, {"id": 3}, {"id": 4}}}
+			first := &memoryDataDestination{tables: map[string]*rowWriter{A.Name(): {}}}
+			second := &memoryDataDestination{tables: map[string]*rowWriter{A.Name(): {}}}
+			coordinator := &memoryDataDestination{tables: map[string]*rowWriter{A.Name(): {}}}
+
+			e := push.PushParallel(ri, []push.DataDestination{first, second}, coordinator, plan, tt.mode, 1, 0, tt.disableConstraints, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false, push.RetryPolicy{})
+			assert.Nil(t, e)
+
+			// the coordinator truncates the tables and disables the constraints once
+			assert.True(t, coordinator.opened)
+			assert.True(t, coordinator.closed)
+			assert.Equal(t, tt.mode, coordinator.mode)
+			assert.Equal(t, tt.disableConstraints, coordinator.disabled)
+			assert.Empty(t, coordinator.tables[A.Name()].rows)
+
+			// the workers only insert
+			for _, worker := range []*memoryDataDestination{first, second} {
+				assert.True(t, worker.opened)
+				assert.True(t, worker.closed)
+				assert.Equal(t, push.Insert, worker.mode)
+				assert.False(t, worker.disabled)
+			}
+			assert.Len(t, append(first.tables[A.Name()].rows, second.tables[A.Name()].rows...), 4)
+		})
+	}
+}
+
+func TestPushParallelWithoutCoordinator(t *testing.T) {
+	A := push.NewTable("A", []string{"id"}, nil)
+	plan := push.NewPlan(A, []push.Relation{})
+
+	ri := &delayedRowIterator{rows: []push.Row{{"id": 1}}}
+	first := &memoryDataDestination{tables: map[string]*rowWriter{A.Name(): {}}}
+
+	e := push.PushParallel(ri, []push.DataDestination{first}, nil, plan, push.Truncate, 1, 0, false, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false, push.RetryPolicy{})
+
+	assert.NotNil(t, e)
+	assert.False(t, first.opened)
+}
+
+// Test: keys generated by the database are recorded and remapped in children
+func TestPushWithGeneratedKeys(t *testing.T) {
+	A := push.NewTable("A", []string{"id"}, nil)
+	B := push.NewTable("B", []string{"id"}, nil)
+	AB := push.NewRelationWithKeys("A->B", A, B, []string{"id"}, []string{"a_id"})
+
+	plan := push.NewPlan(A, []push.Relation{AB})
+	ri := rowIterator{limit: 2, row: push.Row{
+		"id":   1,
+		"name": "John",
+		"A->B": []interface{}{
+			map[string]interface{}{"id": 10, "a_id": 1},
+		},
+	}}
+	tables := map[string]*rowWriter{A.Name(): {}, B.Name(): {}}
+	dest := &memoryDataDestination{tables: tables}
+
+	recorder := &keyRecorder{generated: map[push.Key]push.Cache{}}
+	recorder.Generate(push.Key{TableName: "A", ColumnName: "id"})
+
+	err := push.Push(&ri, dest, plan, push.Insert, 10, 0, true, push.NoErrorCaptureRowWriter{}, recorder, "", "", "", false, push.RetryPolicy{})
+
+	assert.Nil(t, err)
+	// parent is inserted once, without its key
+	assert.Equal(t, []push.Row{{"name": "John"}}, dest.tables[A.Name()].rows)
+	// children reference the generated key
+	assert.Equal(t, 2, len(dest.tables[B.Name()].rows))
+	assert.Equal(t, 1001, dest.tables[B.Name()].rows[0]["a_id"])
+	assert.Equal(t, 1001, dest.tables[B.Name()].rows[1]["a_id"])
+	assert.Equal(t, push.Cache{1: 1001}, recorder.GeneratedKeys()[push.Key{TableName: "A", ColumnName: "id"}])
+}
+
+// Test: a commit batch failing with a transient error is rolled back and replayed
+func TestPushRetryTransientError(t *testing.T) {
+	retry := push.RetryPolicy{MaxAttempts: 3, Backoff: time.Millisecond}
+
+	A := makeTable("A")
+	plan := push.NewPlan(A, []push.Relation{})
+
+	tests := []struct {
+		name             string
+		transientWrites  int
+		transientCommits int
+	}{
+		{"write", 1, 0},
+		{"commit", 0, 1},
+		{"write and commit", 2, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ri := rowIterator{limit: 5, row: push.Row{"name": "John"}}
+			dest := &recoverableDataDestination{transientWrites: tt.transientWrites, transientCommits: tt.transientCommits}
+
+			err := push.Push(&ri, dest, plan, push.Insert, 2, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false, retry)
+
+			assert.Nil(t, err)
+			assert.Len(t, dest.rows, 5)
+			assert.Equal(t, tt.transientWrites+tt.transientCommits, dest.rollbacks)
+			// replayed lines are counted once
+			assert.Equal(t, 5, push.Compute().GetCreatedLinesCount()[A.Name()])
+		})
+	}
+}
+
+// Test: the push stops when a commit batch still fails after the max attempts
+func TestPushRetryExhausted(t *testing.T) {
+	retry := push.RetryPolicy{MaxAttempts: 3, Backoff: time.Millisecond}
+
+	A := makeTable("A")
+	plan := push.NewPlan(A, []push.Relation{})
+	ri := rowIterator{limit: 5, row: push.Row{"name": "John"}}
+	dest := &recoverableDataDestination{transientWrites: 10}
+
+	err := push.Push(&ri, dest, plan, push.Insert, 2, 0, true, push.NoErrorCaptureRowWriter{}, nil, "", "", "", false, retry)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "deadlock (after 3 attempts)", err.Description)
+	assert.Equal(t, 2, dest.rollbacks)
+	assert.Empty(t, dest.rows)
+}
+
+// Test: rejected rows are captured in an envelope describing the failure
+func TestPushCatchErrorsEnvelope(t *testing.T) {
+	A := push.NewTable("A", []string{"id"}, nil)
+	plan := push.NewPlan(A, []push.Relation{})
+	ri := &delayedRowIterator{rows: []push.Row{{"id": 1}, {"id": 2}, {"id": 3}}}
+	dest := &recoverableDataDestination{rejected: 2}
+	catch := &rowWriter{}
+
+	err := push.Push(ri, dest, plan, push.Insert, 10, 0, true, push.NewEnvelopeRowWriter(catch), nil, "", "", "", false, push.RetryPolicy{})
+
+	assert.Nil(t, err)
+	assert.Len(t, dest.rows, 2)
+	assert.Equal(t, []push.Row{{
+		"line":       uint(2),
+		"table":      "A",
+		"mode":       "insert",
+		"code":       "23505",
+		"constraint": "a_pkey",
+		"message":    "duplicate key",
+		"row":        push.Row{"id": 2},
+	}}, catch.rows)
+}
+
+// Test: a rejected row is captured once even if its commit batch is replayed
+func TestPushRetryCatchErrorsOnce(t *testing.T) {
+	retry := push.RetryPolicy{MaxAttempts: 3, Backoff: time.Millisecond}
+
+	A := push.NewTable("A", []string{"id"}, nil)
+	plan := push.NewPlan(A, []push.Relation{})
+	ri := &delayedRowIterator{rows: []push.Row{{"id": 1}, {"id": 2}, {"id": 3}}}
+	dest := &recoverableDataDestination{rejected: 2, transientCommits: 1}
+	catch := &rowWriter{}
+
+	err := push.Push(ri, dest, plan, push.Insert, 10, 0, true, catch, nil, "", "", "", false, retry)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, dest.rollbacks)
+	assert.Equal(t, []push.Row{{"id": 1}, {"id": 3}}, dest.rows)
+	assert.Equal(t, []push.Row{{"id": 2}}, catch.rows)
+}
+
+// Test: every row is written to every target with its own mode and error capture
+func TestPushFanOut(t *testing.T) {
+	A := push.NewTable("A", []string{"id"}, nil)
+	plan := push.NewPlan(A, []push.Relation{})
+
+	rows := []push.Row{}
+	for i := 0; i < 10; i++ {
+		rows = append(rows, push.Row{"id": i})
+	}
+	ri := &delayedRowIterator{rows: rows}
+
+	first := &memoryDataDestination{tables: map[string]*rowWriter{A.Name(): {}}}
+	second := &recoverableDataDestination{rejected: 3}
+	catch := &rowWriter{}
+
+	obs := &mockObserver{}
+	targets := []push.Target{
+		{Name: "first", Destination: first, Mode: push.Insert, CatchError: push.NoErrorCaptureRowWriter{}},
+		{Name: "second", Destination: second, Mode: push.Truncate, CatchError: catch},
+	}
+	err := push.PushFanOut(ri, targets, plan, 4, 0, false, nil, "", "", false, false, push.RetryPolicy{}, obs)
+
+	assert.Nil(t, err)
+	assert.Len(t, first.tables[A.Name()].rows, 10)
+	assert.Equal(t, 3, first.commits)
+	assert.Len(t, second.rows, 9)
+	assert.Equal(t, []push.Row{{"id": 3}}, catch.rows)
+	assert.Equal(t, 10, obs.pushedCount)
+	assert.True(t, obs.closed)
+
+	stats := push.Compute()
+	assert.Equal(t, 10, stats.GetInputLinesCount())
+	assert.Equal(t, &push.DestinationStats{Mode: "insert", InputLinesCount: 10, CommitsCount: 3}, stats.GetDestinations()["first"])
+	assert.Equal(t, &push.DestinationStats{Mode: "truncate", InputLinesCount: 10, ErrorsCount: 1, CommitsCount: 3}, stats.GetDestinations()["second"])
+}
+
+// Test: a failing target is isolated and the others receive all rows
+func TestPushFanOutContinueOnDestinationError(t *testing.T) {
+	A := push.NewTable("A", []string{"id"}, nil)
+	plan := push.NewPlan(A, []push.Relation{})
+
+	newTargets := func() ([]push.Target, *memoryDataDestination) {
+		healthy := &memoryDataDestination{tables: map[string]*rowWriter{A.Name(): {}}}
+		failing := &recoverableDataDestination{transientCommits: 1}
+		return []push.Target{
+			{Name: "failing", Destination: failing, Mode: push.Insert, CatchError: push.NoErrorCaptureRowWriter{}},
+			{Name: "healthy", Destination: healthy, Mode: push.Insert, CatchError: push.NoErrorCaptureRowWriter{}},
+		}, healthy
+	}
+
+	rows := []push.Row{}
+	for i := 0; i < 10; i++ {
+		rows = append(rows, push.Row{"id": i})
+	}
+
+	targets, healthy := newTargets()
+	err := push.PushFanOut(&delayedRowIterator{rows: rows}, targets, plan, 2, 0, false, nil, "", "", false, true, push.RetryPolicy{})
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "destination failing: connection reset")
+	assert.Len(t, healthy.tables[A.Name()].rows, 10)
+	assert.Equal(t, "connection reset", push.Compute().GetDestinations()["failing"].Error)
+	assert.Equal(t, 10, push.Compute().GetDestinations()["healthy"].InputLinesCount)
+
+	targets, healthy = newTargets()
+	err = push.PushFanOut(&delayedRowIterator{rows: rows, delay: 10 * time.Millisecond}, targets, plan, 2, 0, false, nil, "", "", false, false, push.RetryPolicy{})
+
+	assert.NotNil(t, err)
+	assert.Less(t, len(healthy.tables[A.Name()].rows), 10)
+}
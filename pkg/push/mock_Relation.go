@@ -25,6 +25,22 @@ func (_m *MockRelation) Child() Table {
 	return r0
 }
 
+// ChildKeys provides a mock function with given fields:
+func (_m *MockRelation) ChildKeys() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
 // Name provides a mock function with given fields:
 func (_m *MockRelation) Name() string {
 	ret := _m.Called()
@@ -70,3 +86,19 @@ func (_m *MockRelation) Parent() Table {
 
 	return r0
 }
+
+// ParentKeys provides a mock function with given fields:
+func (_m *MockRelation) ParentKeys() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
@@ -19,6 +19,7 @@
 
 import (
 	"encoding/json"
+	"sync"
 	"time"
 
 	over "github.com/adrienaury/zeromdc"
@@ -32,6 +33,8 @@ type Table interface {
 	Columns() ColumnList
 	Import(map[string]interface{}) (ImportedRow, *Error)
 	GetColumn(name string) Column
+	// Version returns the name of the column compared to keep the newer row on conflict, if any
+	Version() string
 }
 
 // ColumnList is a list of columns.
@@ -63,6 +66,8 @@ type Relation interface {
 	Name() string
 	Parent() Table
 	Child() Table
+	ParentKeys() []string
+	ChildKeys() []string
 	OppositeOf(table Table) Table
 }
 
@@ -78,6 +83,12 @@ type Row map[string]interface{
 // Error is the error type returned by the domain
 type Error struct {
 	Description string
+	// Transient is true if the operation can succeed when retried (deadlock, lock timeout, lost connection)
+	Transient bool
+	// Table, Code (error code or SQLSTATE) and Constraint describe the failed write, when available
+	Table      string
+	Code       string
+	Constraint string
 }
 
 func (e *Error) Error() string {
@@ -94,6 +105,7 @@ type ExecutionStats interface {
 	GetDeletedLinesCount() map[string]int
 	GetCommitsCount() int
 	GetDuration() time.Duration
+	GetDestinations() map[string]*DestinationStats
 
 	ToJSON() []byte
 }
@@ -104,8 +116,22 @@ type stats struct {
 	DeletedLinesCount map[string]int `json:"deletedLinesCount"`
 	CommitsCount      int            `json:"commitsCount"`
 	Duration          time.Duration  `json:"duration"`
+
+	Destinations map[string]*DestinationStats `json:"destinations,omitempty"`
+}
+
+// DestinationStats provides an overview of the work done on one target of a fan-out push
+type DestinationStats struct {
+	Mode            string `json:"mode"`
+	InputLinesCount int    `json:"inputLinesCount"`
+	ErrorsCount     int    `json:"errorsCount"`
+	CommitsCount    int    `json:"commitsCount"`
+	Error           string `json:"error,omitempty"`
 }
 
+// statsMutex protects statistics updated by parallel workers
+var statsMutex sync.Mutex
+
 // Reset all statistics to zero
 func Reset() {
 	over.MDC().Set("stats", &stats{CreatedLinesCount: map[string]int{}, DeletedLinesCount: map[string]int{}})
@@ -149,26 +175,82 @@ func (s *stats) GetDuration() time.Duration {
 	return s.Duration
 }
 
+func (s *stats) GetDestinations() map[string]*DestinationStats {
+	return s.Destinations
+}
+
 func IncCreatedLinesCount(table string) {
+	statsMutex.Lock()
+	defer statsMutex.Unlock()
+
 	stats := getStats()
 	stats.CreatedLinesCount[table]++
 }
 
 func IncInputLinesCount() {
+	statsMutex.Lock()
+	defer statsMutex.Unlock()
+
 	stats := getStats()
 	stats.InputLinesCount++
 }
 
 func IncCommitsCount() {
+	statsMutex.Lock()
+	defer statsMutex.Unlock()
+
 	stats := getStats()
 	stats.CommitsCount++
 }
 
 func IncDeletedLinesCount(table string) {
+	statsMutex.Lock()
+	defer statsMutex.Unlock()
+
 	stats := getStats()
 	stats.DeletedLinesCount[table]++
 }
 
+// lineCounts holds the lines written per table since the last commit
+type lineCounts struct {
+	created map[string]int
+	deleted map[string]int
+}
+
+func newLineCounts() lineCounts {
+	return lineCounts{created: map[string]int{}, deleted: map[string]int{}}
+}
+
+// addLinesCounts adds the lines of a committed batch to the statistics
+func addLinesCounts(counts lineCounts) {
+	statsMutex.Lock()
+	defer statsMutex.Unlock()
+
+	stats := getStats()
+	for table, count := range counts.created {
+		stats.CreatedLinesCount[table] += count
+	}
+	for table, count := range counts.deleted {
+		stats.DeletedLinesCount[table] += count
+	}
+}
+
+func setInputLinesCount(count int) {
+	statsMutex.Lock()
+	defer statsMutex.Unlock()
+
+	stats := getStats()
+	stats.InputLinesCount = count
+}
+
+func setDestinationStats(destinations map[string]*DestinationStats) {
+	statsMutex.Lock()
+	defer statsMutex.Unlock()
+
+	stats := getStats()
+	stats.Destinations = destinations
+}
+
 func SetDuration(duration time.Duration) {
 	stats := getStats()
 	stats.Duration = duration
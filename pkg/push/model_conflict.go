@@ -0,0 +1,76 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+// ConflictPolicy tells what to do when a pushed row has the same primary key than an existing row
+type ConflictPolicy byte
+
+const (
+	// ConflictDefault skips conflicting rows in insert and truncate modes, and overwrites them in upsert mode
+	ConflictDefault ConflictPolicy = iota
+	// ConflictSkip keeps the existing row
+	ConflictSkip
+	// ConflictError rejects the pushed row
+	ConflictError
+	// ConflictOverwrite replaces all values of the existing row
+	ConflictOverwrite
+	// ConflictOverwriteNonNull replaces the values of the existing row with non-null pushed values only
+	ConflictOverwriteNonNull
+	// ConflictKeepNewer replaces the existing row if the version column of the pushed row is greater
+	ConflictKeepNewer
+	endConflict
+)
+
+// Conflict policies
+var conflictPolicies = [...]string{
+	"",
+	"skip",
+	"error",
+	"overwrite",
+	"overwrite-non-null",
+	"keep-newer",
+}
+
+// ParseConflictPolicy return conflict policy value of string representation of policy
+func ParseConflictPolicy(policy string) (ConflictPolicy, *Error) {
+	for i, p := range conflictPolicies {
+		if policy == p {
+			return ConflictPolicy(i), nil
+		}
+	}
+	return endConflict, &Error{Description: policy + " is not a valid conflict policy"}
+}
+
+// Resolve returns the policy to apply with mode, if no policy is set
+func (p ConflictPolicy) Resolve(mode Mode) ConflictPolicy {
+	if p != ConflictDefault {
+		return p
+	}
+	if mode == Upsert {
+		return ConflictOverwrite
+	}
+	return ConflictSkip
+}
+
+// String representation
+func (p ConflictPolicy) String() string {
+	if p < endConflict {
+		return conflictPolicies[p]
+	}
+	return "unknown"
+}
@@ -0,0 +1,48 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package push_test
+
+import (
+	"testing"
+
+	"github.com/cgi-fr/lino/pkg/push"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseConflictPolicy(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range []string{"skip", "error", "overwrite", "overwrite-non-null", "keep-newer"} {
+		policy, err := push.ParseConflictPolicy(name)
+		assert.Nil(t, err)
+		assert.Equal(t, name, policy.String())
+	}
+
+	_, err := push.ParseConflictPolicy("replace")
+	assert.NotNil(t, err)
+	assert.Equal(t, "replace is not a valid conflict policy", err.Description)
+}
+
+func TestResolveConflictPolicy(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, push.ConflictSkip, push.ConflictDefault.Resolve(push.Insert))
+	assert.Equal(t, push.ConflictSkip, push.ConflictDefault.Resolve(push.Truncate))
+	assert.Equal(t, push.ConflictOverwrite, push.ConflictDefault.Resolve(push.Upsert))
+	assert.Equal(t, push.ConflictKeepNewer, push.ConflictKeepNewer.Resolve(push.Insert))
+}
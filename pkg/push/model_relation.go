@@ -18,9 +18,11 @@
 package push
 
 type relation struct {
-	name   string
-	parent Table
-	child  Table
+	name       string
+	parent     Table
+	child      Table
+	parentKeys []string
+	childKeys  []string
 }
 
 // NewRelation initialize a new Relation object
@@ -28,10 +30,17 @@ func NewRelation(name string, parent Table, child Table) Relation {
 	return relation{name: name, parent: parent, child: child}
 }
 
-func (r relation) Name() string   { return r.name }
-func (r relation) Parent() Table  { return r.parent }
-func (r relation) Child() Table   { return r.child }
-func (r relation) String() string { return r.name }
+// NewRelationWithKeys initialize a new Relation object with the columns joining parent and child
+func NewRelationWithKeys(name string, parent Table, child Table, parentKeys []string, childKeys []string) Relation {
+	return relation{name: name, parent: parent, child: child, parentKeys: parentKeys, childKeys: childKeys}
+}
+
+func (r relation) Name() string         { return r.name }
+func (r relation) Parent() Table        { return r.parent }
+func (r relation) Child() Table         { return r.child }
+func (r relation) ParentKeys() []string { return r.parentKeys }
+func (r relation) ChildKeys() []string  { return r.childKeys }
+func (r relation) String() string       { return r.name }
 func (r relation) OppositeOf(table Table) Table {
 	if r.Child().Name() == table.Name() {
 		return r.Parent()
@@ -32,6 +32,7 @@ type table struct {
 	name    string
 	pk      []string
 	columns ColumnList
+	version string
 
 	template  jsonline.Template
 	filecache *FileCache
@@ -42,10 +43,16 @@ func NewTable(name string, pk []string, columns ColumnList) Table {
 	return table{name: name, pk: pk, columns: columns, filecache: &FileCache{}}
 }
 
+// NewTableWithVersion initialize a new Table object with a version column
+func NewTableWithVersion(name string, pk []string, columns ColumnList, version string) Table {
+	return table{name: name, pk: pk, columns: columns, version: version, filecache: &FileCache{}}
+}
+
 func (t table) Name() string         { return t.name }
 func (t table) PrimaryKey() []string { return t.pk }
 func (t table) Columns() ColumnList  { return t.columns }
 func (t table) String() string       { return t.name }
+func (t table) Version() string      { return t.version }
 
 func (t table) GetColumn(name string) Column {
 	if t.columns == nil {
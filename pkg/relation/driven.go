@@ -22,6 +22,13 @@ type ExtractorFactory interface {
 	New(url string, schema string) Extractor
 }
 
+// MultiSchemaExtractorFactory creates extractors reading relations across several schemas, the first schema
+// is the default schema : table names in other schemas are qualified by their schema.
+type MultiSchemaExtractorFactory interface {
+	ExtractorFactory
+	NewMultiSchema(url string, schemas []string) Extractor
+}
+
 // Extractor allows to extract relations from a relational database.
 type Extractor interface {
 	Extract() ([]Relation, *Error)
@@ -32,3 +39,21 @@ type Storage interface {
 	List() ([]Relation, *Error)
 	Store(relations []Relation) *Error
 }
+
+// InclusionCheckerFactory exposes methods to create new inclusion checkers.
+type InclusionCheckerFactory interface {
+	New(url string, schema string) InclusionChecker
+}
+
+// InclusionChecker verifies that values of a child key exist in a parent key.
+type InclusionChecker interface {
+	// Check samples at most sampleSize distinct values of the child key, and returns the number of sampled values
+	// and the number of sampled values found in the parent key.
+	Check(parent Table, child Table, sampleSize uint) (int, int, *Error)
+	Close() *Error
+}
+
+// Filter selects the relations to extract by the names of their tables.
+type Filter interface {
+	Match(tableName string) bool
+}
@@ -17,15 +17,101 @@
 
 package relation
 
-// Extract relations from a relational database.
-func Extract(e Extractor, s Storage) *Error {
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Extract relations from a relational database, virtual relations already stored are kept. Extracted relations are
+// kept if the filter (if not nil) selects both tables.
+func Extract(e Extractor, s Storage, filter Filter) *Error {
 	relations, err := e.Extract()
 	if err != nil {
 		return err
 	}
-	err = s.Store(relations)
+
+	stored, err := s.List()
+	if err != nil {
+		return err
+	}
+
+	virtuals := []Relation{}
+	names := map[string]bool{}
+	for _, r := range stored {
+		if r.Virtual {
+			virtuals = append(virtuals, r)
+			names[r.Name] = true
+		}
+	}
+
+	result := []Relation{}
+	for _, r := range relations {
+		if names[r.Name] {
+			log.Warn().Str("relation", r.Name).Msg("extracted relation ignored, a virtual relation has the same name")
+			continue
+		}
+		if filter != nil && (!filter.Match(r.Parent.Name) || !filter.Match(r.Child.Name)) {
+			log.Debug().Str("relation", r.Name).Msg("extracted relation ignored by filter")
+			continue
+		}
+		result = append(result, r)
+	}
+
+	err = s.Store(append(result, virtuals...))
 	if err != nil {
 		return err
 	}
 	return nil
 }
+
+// Add a virtual relation to the storage, the name of the relation must be unique.
+func Add(s Storage, r Relation) *Error {
+	relations, err := s.List()
+	if err != nil {
+		return err
+	}
+
+	for _, existing := range relations {
+		if existing.Name == r.Name {
+			return &Error{Description: fmt.Sprintf("relation %s already exists", r.Name)}
+		}
+	}
+
+	r.Virtual = true
+
+	return s.Store(append(relations, r))
+}
+
+// Remove a relation from the storage.
+func Remove(s Storage, name string) *Error {
+	relations, err := s.List()
+	if err != nil {
+		return err
+	}
+
+	result := []Relation{}
+	for _, r := range relations {
+		if r.Name != name {
+			result = append(result, r)
+		}
+	}
+
+	if len(result) == len(relations) {
+		return &Error{Description: fmt.Sprintf("no relation named %s", name)}
+	}
+
+	return s.Store(result)
+}
+
+// List all stored relations.
+func List(s Storage) ([]Relation, *Error) {
+	relations, err := s.List()
+	if err != nil {
+		return nil, err
+	}
+	if relations == nil {
+		relations = []Relation{}
+	}
+	return relations, nil
+}
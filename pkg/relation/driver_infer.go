@@ -0,0 +1,207 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package relation
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// scoreInclusion is added to the naming score when all sampled child values exist in the parent key
+	scoreInclusion = 0.4
+	// penaltyType is removed from the naming score when the child column and the parent key have different types
+	penaltyType = 0.2
+)
+
+// Infer proposes relations between tables without declared constraints. A column is a candidate foreign key
+// when its name refers to a table with a single column primary key (customer_id for customer.id or
+// customer.customer_id). The confidence given by the name is raised when the sampled child values all exist in the parent
+// key, and lowered otherwise. Relations already stored are not proposed, candidates are sorted by decreasing confidence.
+func Infer(tables []TableDefinition, checker InclusionChecker, existing []Relation, cfg InferConfig) ([]Candidate, *Error) {
+	known := map[string]bool{}
+	for _, r := range existing {
+		known[relationKey(r)] = true
+	}
+
+	candidates := []Candidate{}
+
+	for _, parent := range tables {
+		if len(parent.Keys) != 1 || parent.Keys[0] == "" {
+			continue
+		}
+
+		for _, child := range tables {
+			if child.Name == parent.Name {
+				continue
+			}
+
+			for _, column := range child.Columns {
+				score := namingScore(parent, column.Name)
+				if score == 0 {
+					continue
+				}
+
+				if keyType := columnType(parent, parent.Keys[0]); keyType != "" && column.Type != "" && !strings.EqualFold(keyType, column.Type) {
+					score -= penaltyType
+				}
+
+				candidate := Candidate{
+					Relation: Relation{
+						Name:    fmt.Sprintf("%s_%s_inferred", baseName(child.Name), column.Name),
+						Parent:  Table{Name: parent.Name, Keys: []string{parent.Keys[0]}},
+						Child:   Table{Name: child.Name, Keys: []string{column.Name}},
+						Virtual: true,
+					},
+					Confidence: score,
+				}
+
+				if known[relationKey(candidate.Relation)] {
+					continue
+				}
+
+				if cfg.SampleSize > 0 && checker != nil {
+					sampled, found, err := checker.Check(candidate.Relation.Parent, candidate.Relation.Child, cfg.SampleSize)
+					if err != nil {
+						log.Warn().Str("relation", candidate.Relation.Name).Str("error", err.Description).Msg("inclusion check failed, candidate ignored")
+						continue
+					}
+
+					candidate.Sampled = sampled
+					candidate.Found = found
+
+					switch {
+					case sampled == 0:
+					case found == sampled:
+						candidate.Confidence += scoreInclusion
+					default:
+						candidate.Confidence *= float64(found) / float64(sampled)
+					}
+				}
+
+				candidate.Confidence = math.Round(math.Max(0, math.Min(1, candidate.Confidence))*100) / 100
+
+				if candidate.Confidence >= cfg.MinConfidence && candidate.Confidence > 0 {
+					candidates = append(candidates, candidate)
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Confidence != candidates[j].Confidence {
+			return candidates[i].Confidence > candidates[j].Confidence
+		}
+		return candidates[i].Relation.Name < candidates[j].Relation.Name
+	})
+
+	return candidates, nil
+}
+
+// Accept stores the candidates as virtual relations, candidates with the name of a stored relation are ignored.
+// It returns the number of stored candidates.
+func Accept(s Storage, candidates []Candidate) (int, *Error) {
+	relations, err := s.List()
+	if err != nil {
+		return 0, err
+	}
+
+	names := map[string]bool{}
+	for _, r := range relations {
+		names[r.Name] = true
+	}
+
+	accepted := 0
+	for _, candidate := range candidates {
+		if names[candidate.Relation.Name] {
+			log.Warn().Str("relation", candidate.Relation.Name).Msg("candidate ignored, a relation has the same name")
+			continue
+		}
+		names[candidate.Relation.Name] = true
+
+		r := candidate.Relation
+		r.Virtual = true
+		relations = append(relations, r)
+		accepted++
+	}
+
+	if accepted == 0 {
+		return 0, nil
+	}
+
+	return accepted, s.Store(relations)
+}
+
+// namingScore gives the confidence that the column refers to the primary key of the parent table by its name.
+func namingScore(parent TableDefinition, column string) float64 {
+	key := strings.ToLower(parent.Keys[0])
+	column = strings.ToLower(column)
+	base := strings.ToLower(baseName(parent.Name))
+
+	for _, name := range []string{base, singular(base)} {
+		switch {
+		case column == name+"_"+key:
+			// customer_id for customer.id
+			return 0.6
+		case column == name+key:
+			// customerid for customer.id
+			return 0.5
+		case strings.HasPrefix(key, name) && column == key:
+			// customer_id for customer.customer_id
+			return 0.5
+		case strings.HasSuffix(column, "_"+name+"_"+key), strings.HasPrefix(key, name) && strings.HasSuffix(column, "_"+key):
+			// billing_customer_id for customer.id or customer.customer_id
+			return 0.4
+		}
+	}
+
+	return 0
+}
+
+// singular removes the plural mark of a table name (customers, categories).
+func singular(name string) string {
+	switch {
+	case strings.HasSuffix(name, "ies"):
+		return strings.TrimSuffix(name, "ies") + "y"
+	case strings.HasSuffix(name, "s") && !strings.HasSuffix(name, "ss"):
+		return strings.TrimSuffix(name, "s")
+	}
+	return name
+}
+
+// baseName removes the schema from a table name.
+func baseName(table string) string {
+	return table[strings.LastIndex(table, ".")+1:]
+}
+
+func columnType(table TableDefinition, name string) string {
+	for _, column := range table.Columns {
+		if column.Name == name {
+			return column.Type
+		}
+	}
+	return ""
+}
+
+func relationKey(r Relation) string {
+	return fmt.Sprintf("%s(%s)->%s(%s)", r.Parent.Name, strings.Join(r.Parent.Keys, ","), r.Child.Name, strings.Join(r.Child.Keys, ","))
+}
@@ -18,6 +18,7 @@
 package relation_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/cgi-fr/lino/pkg/relation"
@@ -72,7 +73,7 @@ func TestExtractEmptyDatabase(t *testing.T) {
 		return []relation.Relation{}, nil
 	}}
 
-	err := relation.Extract(Extractor, storage)
+	err := relation.Extract(Extractor, storage, nil)
 
 	assert.Nil(t, err, "An error occurred while using Add method")
 	assert.Empty(t, storage.repo, "The relations storage should be empty")
@@ -95,25 +96,73 @@ func TestExtractNonEmptyDatabase(t *testing.T) {
 		return []relation.Relation{relation1}, nil
 	}}
 
-	err := relation.Extract(Extractor, storage)
+	err := relation.Extract(Extractor, storage, nil)
 
 	assert.Nil(t, err, "An error occurred while using Add method")
 	assert.Len(t, storage.repo, 1, "The relations storage should contains 1 relation")
 	assert.ElementsMatch(t, storage.repo, []relation.Relation{relation1}, "Unexpected relations storage content")
 }
 
+type prefixFilter string
+
+func (f prefixFilter) Match(tableName string) bool {
+	return strings.HasPrefix(tableName, string(f))
+}
+
+func TestExtractFilter(t *testing.T) {
+	kept := relation.Relation{
+		Name:   "kept",
+		Parent: relation.Table{Name: "sales_customer", Keys: []string{"id"}},
+		Child:  relation.Table{Name: "sales_order", Keys: []string{"customer_id"}},
+	}
+	ignored := relation.Relation{
+		Name:   "ignored",
+		Parent: relation.Table{Name: "sales_order", Keys: []string{"id"}},
+		Child:  relation.Table{Name: "audit_order", Keys: []string{"order_id"}},
+	}
+	storage := &MemoryStorage{}
+	Extractor := &MockExtractor{fn: func() ([]relation.Relation, *relation.Error) {
+		return []relation.Relation{kept, ignored}, nil
+	}}
+
+	err := relation.Extract(Extractor, storage, prefixFilter("sales_"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []relation.Relation{kept}, storage.repo)
+}
+
 func TestExtractorror(t *testing.T) {
 	storage := &MemoryStorage{}
 	Extractor := &MockExtractor{fn: func() ([]relation.Relation, *relation.Error) {
 		return nil, &relation.Error{Description: "expected error"}
 	}}
 
-	err := relation.Extract(Extractor, storage)
+	err := relation.Extract(Extractor, storage, nil)
 
 	assert.NotNil(t, err, "An error should occur while using Extract method")
 	assert.EqualError(t, err, "expected error")
 }
 
+func TestExtractListError(t *testing.T) {
+	Extractor := &MockExtractor{fn: func() ([]relation.Relation, *relation.Error) {
+		return []relation.Relation{}, nil
+	}}
+	storage := &MockStorage{
+		fnList: func() ([]relation.Relation, *relation.Error) {
+			return nil, &relation.Error{Description: "invalid version in ./relations.yaml (v0)"}
+		},
+		fnStore: func(relations []relation.Relation) *relation.Error {
+			t.Fatal("stored relations should not be overwritten")
+			return nil
+		},
+	}
+
+	err := relation.Extract(Extractor, storage, nil)
+
+	assert.NotNil(t, err, "An error should occur while using Extract method")
+	assert.EqualError(t, err, "invalid version in ./relations.yaml (v0)")
+}
+
 func TestStoreError(t *testing.T) {
 	relation1 := relation.Relation{
 		Name: "Relation1",
@@ -130,13 +179,155 @@ func TestStoreError(t *testing.T) {
 		return []relation.Relation{relation1}, nil
 	}}
 	storage := &MockStorage{
+		fnList: func() ([]relation.Relation, *relation.Error) {
+			return []relation.Relation{}, nil
+		},
 		fnStore: func(relations []relation.Relation) *relation.Error {
 			return &relation.Error{Description: "expected error"}
 		},
 	}
 
-	err := relation.Extract(Extractor, storage)
+	err := relation.Extract(Extractor, storage, nil)
 
 	assert.NotNil(t, err, "An error should occur while using Extract method")
 	assert.EqualError(t, err, "expected error")
 }
+
+func TestExtractKeepsVirtualRelations(t *testing.T) {
+	virtual := relation.Relation{
+		Name:    "Virtual",
+		Parent:  relation.Table{Name: "Table1", Keys: []string{"Table1_key"}},
+		Child:   relation.Table{Name: "Table3", Keys: []string{"Table3_key"}},
+		Virtual: true,
+	}
+	extracted := relation.Relation{
+		Name:   "Relation1",
+		Parent: relation.Table{Name: "Table1", Keys: []string{"Table1_key"}},
+		Child:  relation.Table{Name: "Table2", Keys: []string{"Table2_key"}},
+	}
+	obsolete := relation.Relation{
+		Name:   "Obsolete",
+		Parent: relation.Table{Name: "Table4", Keys: []string{"Table4_key"}},
+		Child:  relation.Table{Name: "Table5", Keys: []string{"Table5_key"}},
+	}
+	storage := &MemoryStorage{repo: []relation.Relation{obsolete, virtual}}
+	Extractor := &MockExtractor{fn: func() ([]relation.Relation, *relation.Error) {
+		return []relation.Relation{extracted, {Name: "Virtual", Parent: extracted.Parent, Child: extracted.Child}}, nil
+	}}
+
+	err := relation.Extract(Extractor, storage, nil)
+
+	assert.Nil(t, err, "An error occurred while using Extract method")
+	assert.Equal(t, []relation.Relation{extracted, virtual}, storage.repo, "Unexpected relations storage content")
+}
+
+func TestAddAndRemove(t *testing.T) {
+	relation1 := relation.Relation{
+		Name:   "Relation1",
+		Parent: relation.Table{Name: "Table1", Keys: []string{"Table1_key"}},
+		Child:  relation.Table{Name: "Table2", Keys: []string{"Table2_key"}},
+	}
+	storage := &MemoryStorage{repo: []relation.Relation{}}
+
+	err := relation.Add(storage, relation1)
+
+	assert.Nil(t, err, "An error occurred while using Add method")
+	assert.Len(t, storage.repo, 1, "The relations storage should contains 1 relation")
+	assert.True(t, storage.repo[0].Virtual, "An added relation should be virtual")
+
+	err = relation.Add(storage, relation1)
+
+	assert.EqualError(t, err, "relation Relation1 already exists")
+
+	err = relation.Remove(storage, "Relation1")
+
+	assert.Nil(t, err, "An error occurred while using Remove method")
+	assert.Empty(t, storage.repo, "The relations storage should be empty")
+
+	err = relation.Remove(storage, "Relation1")
+
+	assert.EqualError(t, err, "no relation named Relation1")
+}
+
+// MockInclusionChecker returns the number of sampled and found values by child column
+type MockInclusionChecker struct {
+	found map[string][2]int
+}
+
+// Check returns the configured result for the child column
+func (c *MockInclusionChecker) Check(parent relation.Table, child relation.Table, sampleSize uint) (int, int, *relation.Error) {
+	result, ok := c.found[child.Name+"."+child.Keys[0]]
+	if !ok {
+		return 0, 0, &relation.Error{Description: "expected error"}
+	}
+	return result[0], result[1], nil
+}
+
+// Close does nothing
+func (c *MockInclusionChecker) Close() *relation.Error {
+	return nil
+}
+
+func TestInfer(t *testing.T) {
+	tables := []relation.TableDefinition{
+		{Name: "public.customers", Keys: []string{"id"}, Columns: []relation.Column{{Name: "id", Type: "int4"}, {Name: "name"}}},
+		{Name: "public.language", Keys: []string{"language_id"}, Columns: []relation.Column{{Name: "language_id", Type: "int2"}}},
+		{Name: "public.orders", Keys: []string{"id"}, Columns: []relation.Column{
+			{Name: "id", Type: "int4"},
+			{Name: "customer_id", Type: "int4"},
+			{Name: "billing_customer_id", Type: "int4"},
+			{Name: "language_id", Type: "int4"},
+			{Name: "original_language_id", Type: "int2"},
+		}},
+	}
+	checker := &MockInclusionChecker{found: map[string][2]int{
+		"public.orders.customer_id":          {10, 10},
+		"public.orders.billing_customer_id":  {10, 5},
+		"public.orders.language_id":          {0, 0},
+		"public.orders.original_language_id": {4, 4},
+	}}
+	existing := []relation.Relation{{
+		Name:   "orders_language_fkey",
+		Parent: relation.Table{Name: "public.language", Keys: []string{"language_id"}},
+		Child:  relation.Table{Name: "public.orders", Keys: []string{"original_language_id"}},
+	}}
+
+	candidates, err := relation.Infer(tables, checker, existing, relation.InferConfig{SampleSize: 10})
+
+	assert.Nil(t, err, "An error occurred while using Infer method")
+	assert.Len(t, candidates, 3)
+	assert.Equal(t, "orders_customer_id_inferred", candidates[0].Relation.Name)
+	assert.Equal(t, relation.Table{Name: "public.customers", Keys: []string{"id"}}, candidates[0].Relation.Parent)
+	assert.Equal(t, relation.Table{Name: "public.orders", Keys: []string{"customer_id"}}, candidates[0].Relation.Child)
+	assert.Equal(t, 1.0, candidates[0].Confidence)
+	assert.Equal(t, 10, candidates[0].Found)
+	assert.Equal(t, "orders_language_id_inferred", candidates[1].Relation.Name)
+	assert.Equal(t, 0.3, candidates[1].Confidence, "type mismatch and no sampled values")
+	assert.Equal(t, "orders_billing_customer_id_inferred", candidates[2].Relation.Name)
+	assert.Equal(t, 0.2, candidates[2].Confidence, "half of the sampled values are missing")
+
+	candidates, err = relation.Infer(tables, checker, existing, relation.InferConfig{SampleSize: 10, MinConfidence: 0.5})
+
+	assert.Nil(t, err, "An error occurred while using Infer method")
+	assert.Len(t, candidates, 1)
+}
+
+func TestAccept(t *testing.T) {
+	relation1 := relation.Relation{
+		Name:   "Relation1",
+		Parent: relation.Table{Name: "Table1", Keys: []string{"id"}},
+		Child:  relation.Table{Name: "Table2", Keys: []string{"table1_id"}},
+	}
+	storage := &MemoryStorage{repo: []relation.Relation{relation1}}
+
+	accepted, err := relation.Accept(storage, []relation.Candidate{
+		{Relation: relation1, Confidence: 1},
+		{Relation: relation.Relation{Name: "Relation2", Parent: relation1.Parent, Child: relation.Table{Name: "Table3", Keys: []string{"table1_id"}}}},
+	})
+
+	assert.Nil(t, err, "An error occurred while using Accept method")
+	assert.Equal(t, 1, accepted)
+	assert.Len(t, storage.repo, 2, "The relations storage should contains 2 relations")
+	assert.Equal(t, "Relation2", storage.repo[1].Name)
+	assert.True(t, storage.repo[1].Virtual, "An accepted relation should be virtual")
+}
@@ -24,10 +24,12 @@ type Table struct {
 }
 
 // Relation holds a parent Table and a child Table.
+// A virtual relation is declared by hand instead of being extracted from a foreign key constraint.
 type Relation struct {
-	Name   string
-	Parent Table
-	Child  Table
+	Name    string
+	Parent  Table
+	Child   Table
+	Virtual bool
 }
 
 // Error is the error type returned by the domain.
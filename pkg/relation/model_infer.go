@@ -0,0 +1,49 @@
+// Copyright (C) 2026 CGI France
+//
+// This file is part of LINO.
+//
+// LINO is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// LINO is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with LINO.  If not, see <http://www.gnu.org/licenses/>.
+
+package relation
+
+// Column holds the name and the database type of a column, the type is empty if unknown.
+type Column struct {
+	Name string
+	Type string
+}
+
+// TableDefinition holds the primary key and the columns of a table, used to infer relations.
+type TableDefinition struct {
+	Name    string
+	Keys    []string
+	Columns []Column
+}
+
+// InferConfig holds the configuration of the inference of relations.
+type InferConfig struct {
+	// SampleSize is the number of distinct child values checked in the parent key, 0 disables the check
+	SampleSize uint
+	// MinConfidence is the minimum confidence of the proposed relations
+	MinConfidence float64
+}
+
+// Candidate is a relation proposed by the inference, with a confidence between 0 and 1.
+type Candidate struct {
+	Relation   Relation
+	Confidence float64
+	// Sampled is the number of distinct child values checked in the parent key
+	Sampled int
+	// Found is the number of sampled child values found in the parent key
+	Found int
+}
@@ -17,3 +17,8 @@ type Storage interface {
 	List() ([]Sequence, *Error)
 	Store(sequences []Sequence) *Error
 }
+
+// Filter selects the tables whose sequences are extracted.
+type Filter interface {
+	Match(tableName string) bool
+}
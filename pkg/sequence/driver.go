@@ -6,7 +6,8 @@
 	"github.com/rs/zerolog/log"
 )
 
-func Extract(e Updator, tables []Table, s Storage) *Error {
+// Extract the sequences of the keys of the tables, keeping the tables selected by the filter (if not nil).
+func Extract(e Updator, tables []Table, s Storage, filter Filter) *Error {
 	seqs, err := e.Extract()
 	if err != nil {
 		return err
@@ -16,6 +17,18 @@ func Extract(e Updator, tables []Table, s Storage) *Error {
 
 	for _, seq := range seqs {
 		for _, tab := range tables {
+			if filter != nil && !filter.Match(tab.Name) {
+				continue
+			}
+			if table, column, ok := IdentityColumn(seq); ok {
+				// identity columns belong to the table of their name only
+				if table == tab.Name {
+					log.Debug().Str("table", tab.Name).Str("sequence", seq).Msg("Identity - table match")
+
+					sequences = append(sequences, Sequence{Name: seq, Table: table, Column: column})
+				}
+				continue
+			}
 			for _, key := range tab.Keys {
 				if strings.Contains(seq, tab.Name) && strings.Contains(seq, key) {
 					log.Debug().Str("table", tab.Name).Str("sequence", seq).Msg("Sequence - table match")
@@ -0,0 +1,49 @@
+package sequence_test
+
+import (
+	"testing"
+
+	"github.com/cgi-fr/lino/pkg/sequence"
+	"github.com/stretchr/testify/assert"
+)
+
+type memoryUpdator struct {
+	sequences []string
+}
+
+func (u memoryUpdator) Extract() ([]string, *sequence.Error) { return u.sequences, nil }
+
+func (u memoryUpdator) Status(seq sequence.Sequence) (sequence.Sequence, *sequence.Error) {
+	return seq, nil
+}
+
+func (u memoryUpdator) Update([]sequence.Sequence) *sequence.Error { return nil }
+
+type memoryStorage struct {
+	sequences []sequence.Sequence
+}
+
+func (s *memoryStorage) List() ([]sequence.Sequence, *sequence.Error) { return s.sequences, nil }
+
+func (s *memoryStorage) Store(sequences []sequence.Sequence) *sequence.Error {
+	s.sequences = sequences
+	return nil
+}
+
+func TestExtractIdentityColumns(t *testing.T) {
+	updator := memoryUpdator{sequences: []string{"order.id", "order_item.id", "order_id_seq"}}
+	tables := []sequence.Table{
+		{Name: "order", Keys: []string{"id"}},
+		{Name: "order_item", Keys: []string{"id"}},
+	}
+	storage := &memoryStorage{}
+
+	err := sequence.Extract(updator, tables, storage, nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []sequence.Sequence{
+		{Name: "order.id", Table: "order", Column: "id"},
+		{Name: "order_item.id", Table: "order_item", Column: "id"},
+		{Name: "order_id_seq", Table: "order", Column: "id"},
+	}, storage.sequences)
+}
@@ -1,5 +1,7 @@
 package sequence
 
+import "strings"
+
 type Sequence struct {
 	Name   string
 	Table  string
@@ -15,3 +17,13 @@ type Table struct {
 	Name string
 	Keys []string
 }
+
+// IdentityColumn splits the name table.column under which an identity or auto increment column is listed along with
+// the sequences.
+func IdentityColumn(name string) (string, string, bool) {
+	i := strings.LastIndex(name, ".")
+	if i < 0 {
+		return "", "", false
+	}
+	return name[:i], name[i+1:], true
+}
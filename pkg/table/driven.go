@@ -22,6 +22,14 @@ type ExtractorFactory interface {
 	New(url string, schema string) Extractor
 }
 
+// ScopedExtractorFactory creates extractors reading several schemas and other types of objects than tables.
+type ScopedExtractorFactory interface {
+	ExtractorFactory
+	// NewScoped returns an extractor of the objects of the types in the schemas, the first schema is the default schema
+	// and the objects of the other schemas have schema-qualified names
+	NewScoped(url string, schemas []string, types []ObjectType) Extractor
+}
+
 // Extractor allows to extract primary keys from a relational database.
 type Extractor interface {
 	Extract(onlyTables bool, withDBInfos bool) ([]Table, *Error)
@@ -33,3 +41,8 @@ type Storage interface {
 	List() ([]Table, *Error)
 	Store(tables []Table) *Error
 }
+
+// Filter selects the tables to extract by name.
+type Filter interface {
+	Match(tableName string) bool
+}
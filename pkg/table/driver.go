@@ -22,13 +22,20 @@
 	"sort"
 )
 
-// Extract table metadatas from a relational database.
-func Extract(e Extractor, s Storage, onlyTables bool, withDBInfos bool) *Error {
-	tables, err := e.Extract(onlyTables, withDBInfos)
+// Extract table metadatas from a relational database, keeping the tables selected by the filter (if not nil).
+func Extract(e Extractor, s Storage, onlyTables bool, withDBInfos bool, filter Filter) *Error {
+	extracted, err := e.Extract(onlyTables, withDBInfos)
 	if err != nil {
 		return err
 	}
 
+	tables := []Table{}
+	for _, t := range extracted {
+		if filter == nil || filter.Match(t.Name) {
+			tables = append(tables, t)
+		}
+	}
+
 	if !onlyTables {
 		SortKeysByColumnOrder(tables)
 	}
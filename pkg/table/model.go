@@ -17,13 +17,19 @@
 
 package table
 
-// DBInfo holds the type of a column, adding length or size precision if columns fixed thoses info.
+// DBInfo holds the type of a column, adding length or size precision if columns fixed thoses info,
+// and the constraints of the column.
 type DBInfo struct {
 	Type      string
 	Length    int64
 	Size      int64
 	Precision int64
 	ByteBased bool
+	NotNull   bool
+	Default   string
+	Comment   string
+	Identity  bool
+	Generated string // expression of a column computed by the database
 }
 
 // Column holds the name of a column.
@@ -42,12 +48,41 @@ type Column struct {
 	ExportModeAll
 )
 
+// Index holds the columns of an index, in the order of the index.
+type Index struct {
+	Name    string
+	Columns []string
+	Unique  bool
+}
+
+// Check holds the expression of a check constraint.
+type Check struct {
+	Name       string
+	Expression string
+}
+
+// ObjectType is the type of a database object extracted as a table.
+type ObjectType string
+
+// Definition of the possible values for ObjectType.
+const (
+	ObjectTypeTable            ObjectType = "table"
+	ObjectTypeView             ObjectType = "view"
+	ObjectTypeMaterializedView ObjectType = "materialized-view"
+	ObjectTypeSynonym          ObjectType = "synonym"
+)
+
 // Table holds a name (table name) and a list of keys (table columns).
 type Table struct {
 	Name       string
+	Type       ObjectType // empty for tables
 	Keys       []string
 	Columns    []Column
 	ExportMode ExportMode
+	Version    string
+	Comment    string
+	Indexes    []Index
+	Checks     []Check
 }
 
 // Error is the error type returned by the domain